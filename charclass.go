@@ -0,0 +1,218 @@
+package vee
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	CodeCharacter     = "character"
+	CodeNormalization = "normalization"
+	CodePrefix        = "prefix"
+	CodeSuffix        = "suffix"
+	CodeContains      = "contains"
+)
+
+type (
+	OnlyPredicateConstraint struct {
+		Value        string
+		ErrorMessage string
+		Predicate    func(rune) bool
+	}
+
+	NFCNormalizedConstraint struct {
+		Value string
+	}
+
+	AffixConstraint struct {
+		Value  string
+		Affix  string
+		Suffix bool
+	}
+
+	ContainsFoldConstraint struct {
+		Value string
+		Str   string
+	}
+
+	// ErrInvalidRune reports the first rune of a string that breaks a constraint, Position is the zero based index
+	// of the rune, counted in runes.
+	ErrInvalidRune struct {
+		Code     string
+		Message  string
+		Rune     rune
+		Position int
+	}
+)
+
+// OnlyPredicate checks that every rune of the value satisfies predicate, message describes the rule and the error
+// adds the first offending rune and its position.
+func OnlyPredicate(predicate func(rune) bool, message string) CheckableValue[string] {
+	return &OnlyPredicateConstraint{
+		Predicate:    predicate,
+		ErrorMessage: message,
+	}
+}
+
+// Alpha checks that the value contains Unicode letters only.
+func Alpha() CheckableValue[string] {
+	return OnlyPredicate(unicode.IsLetter, "must contain letters only")
+}
+
+// Alphanumeric checks that the value contains Unicode letters and decimal digits only.
+func Alphanumeric() CheckableValue[string] {
+	return OnlyPredicate(isAlphanumeric, "must contain letters and digits only")
+}
+
+func ASCII() CheckableValue[string] {
+	return OnlyPredicate(isASCII, "must contain ASCII characters only")
+}
+
+// PrintableASCII checks that the value contains ASCII characters from space to tilde only.
+func PrintableASCII() CheckableValue[string] {
+	return OnlyPredicate(isPrintableASCII, "must contain printable ASCII characters only")
+}
+
+func NoControlChars() CheckableValue[string] {
+	return OnlyPredicate(isNotControl, "cannot contain control characters")
+}
+
+func NoWhitespace() CheckableValue[string] {
+	return OnlyPredicate(isNotSpace, "cannot contain whitespace")
+}
+
+// UnicodeScripts checks that every rune belongs to one of scripts, such as unicode.Latin. Runes of the Common and
+// Inherited scripts, such as digits, punctuation, spaces and combining marks, are always accepted.
+func UnicodeScripts(scripts ...*unicode.RangeTable) CheckableValue[string] {
+	allowed := append([]*unicode.RangeTable{unicode.Common, unicode.Inherited}, scripts...)
+	return &OnlyPredicateConstraint{
+		Predicate: func(r rune) bool {
+			return unicode.IsOneOf(allowed, r)
+		},
+		ErrorMessage: "contains a character of a script that is not allowed",
+	}
+}
+
+// NFCNormalized checks that the value is in Unicode Normalization Form C.
+func NFCNormalized() CheckableValue[string] {
+	return new(NFCNormalizedConstraint)
+}
+
+func HasPrefix(prefix string) CheckableValue[string] {
+	return &AffixConstraint{
+		Affix: prefix,
+	}
+}
+
+func HasSuffix(suffix string) CheckableValue[string] {
+	return &AffixConstraint{
+		Affix:  suffix,
+		Suffix: true,
+	}
+}
+
+// ContainsFold is like Contains but compares using Unicode case folding.
+func ContainsFold(str string) CheckableValue[string] {
+	return &ContainsFoldConstraint{
+		Str: str,
+	}
+}
+
+func (c *OnlyPredicateConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *OnlyPredicateConstraint) Check() error {
+	pos := 0
+	for _, r := range c.Value {
+		if !c.Predicate(r) {
+			return ErrInvalidRune{Code: CodeCharacter, Message: c.ErrorMessage, Rune: r, Position: pos}
+		}
+		pos++
+	}
+
+	return nil
+}
+
+func (c *NFCNormalizedConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *NFCNormalizedConstraint) Check() error {
+	if norm.NFC.IsNormalString(c.Value) {
+		return nil
+	}
+
+	// QuickSpanString stops at the start of the segment that needs normalization
+	i := norm.NFC.QuickSpanString(c.Value)
+	r, _ := utf8.DecodeRuneInString(c.Value[i:])
+	return ErrInvalidRune{
+		Code:     CodeNormalization,
+		Message:  "must be in Unicode normalization form C",
+		Rune:     r,
+		Position: utf8.RuneCountInString(c.Value[:i]),
+	}
+}
+
+func (c *AffixConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *AffixConstraint) Check() error {
+	if c.Suffix {
+		if !strings.HasSuffix(c.Value, c.Affix) {
+			return ConstraintError(CodeSuffix, fmt.Sprintf(`must end with "%s"`, c.Affix))
+		}
+		return nil
+	}
+
+	if !strings.HasPrefix(c.Value, c.Affix) {
+		return ConstraintError(CodePrefix, fmt.Sprintf(`must start with "%s"`, c.Affix))
+	}
+	return nil
+}
+
+func (c *ContainsFoldConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *ContainsFoldConstraint) Check() error {
+	fold := cases.Fold()
+	if !strings.Contains(fold.String(c.Value), fold.String(c.Str)) {
+		return ConstraintError(CodeContains, fmt.Sprintf(`must contain the string "%s"`, c.Str))
+	}
+	return nil
+}
+
+func (e ErrInvalidRune) Error() string {
+	return fmt.Sprintf("%s, found %q at position %d", e.Message, e.Rune, e.Position)
+}
+
+func (e ErrInvalidRune) errorCode() string {
+	return e.Code
+}
+
+func isAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isASCII(r rune) bool {
+	return r < utf8.RuneSelf
+}
+
+func isPrintableASCII(r rune) bool {
+	return r >= ' ' && r <= '~'
+}
+
+func isNotControl(r rune) bool {
+	return !unicode.IsControl(r)
+}
+
+func isNotSpace(r rune) bool {
+	return !unicode.IsSpace(r)
+}
@@ -0,0 +1,52 @@
+package vee
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+)
+
+func TestCharacterClasses(t *testing.T) {
+	var tests = []testCase{
+		{"alpha", Value("Zoë", Alpha()), "", false},
+		{"alpha with digit", Value("abc1", Alpha()), CodeCharacter, true},
+		{"alphanumeric", Value("abc123", Alphanumeric()), "", false},
+		{"alphanumeric with space", Value("ab c", Alphanumeric()), CodeCharacter, true},
+		{"ascii", Value("abc", ASCII()), "", false},
+		{"non ascii", Value("naïve", ASCII()), CodeCharacter, true},
+		{"printable ascii with tab", Value("a\tb", PrintableASCII()), CodeCharacter, true},
+		{"control character", Value("ab\x00", NoControlChars()), CodeCharacter, true},
+		{"whitespace", Value("a b", NoWhitespace()), CodeCharacter, true},
+		{"latin", Value("Jean-Luc Picard 2", UnicodeScripts(unicode.Latin)), "", false},
+		{"cyrillic in latin", Value("pаypal", UnicodeScripts(unicode.Latin)), CodeCharacter, true},
+		{"nfc", Value("café", NFCNormalized()), "", false},
+		{"nfd", Value("café", NFCNormalized()), CodeNormalization, true},
+		{"prefix", Value("sk_live_123", HasPrefix("sk_")), "", false},
+		{"missing prefix", Value("pk_live_123", HasPrefix("sk_")), CodePrefix, true},
+		{"missing suffix", Value("report.txt", HasSuffix(".csv")), CodeSuffix, true},
+		{"contains fold", Value("Straße", ContainsFold("STRASSE")), "", false},
+		{"does not contain fold", Value("Hello", ContainsFold("world")), CodeContains, true},
+	}
+
+	runCases(t, tests)
+
+	var positions = []struct {
+		check    Checkable
+		position int
+	}{
+		{Value("abc1", Alpha()), 3},
+		{Value("ab c", Alphanumeric()), 2},
+		{Value("naïve", ASCII()), 2},
+		{Value("a\tb", PrintableASCII()), 1},
+		{Value("ab\x00", NoControlChars()), 2},
+		{Value("a b", NoWhitespace()), 1},
+		{Value("pаypal", UnicodeScripts(unicode.Latin)), 1},
+		{Value("café", NFCNormalized()), 3},
+	}
+	for _, p := range positions {
+		var ir ErrInvalidRune
+		if err := p.check.Check(); !errors.As(err, &ir) || ir.Position != p.position {
+			t.Errorf("error(%v), should be at position %d", err, p.position)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package vee
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	// CheckOption changes how a single CheckWith call checks, it reaches every constraint nested in the checked one.
+	CheckOption func(*CheckContext)
+
+	// CheckContext carries the options of a CheckWith call to nested constraints, a nil *CheckContext is a plain
+	// Check.
+	CheckContext struct {
+		semantic    CheckSemantic
+		semanticSet bool
+
+		// path is the dotted path of the field being checked and mask the paths to check, nil to check all.
+		path string
+		mask map[string]bool
+
+		groups    []string
+		warnings  *ErrList
+		tracer    *tracer
+		observers []Observer
+
+		// sensitive is set inside a SensitiveField.
+		sensitive bool
+	}
+
+	// Schematic is implemented by types that expose the schema their Validate checks. When a CheckWith option must
+	// reach nested fields, that is WithMask, WithGroups, CollectWarnings, WithTrace or WithObserver, nested values are
+	// checked through Schema instead of Validate, Validate must then check nothing beyond its schema or the extra
+	// checks are skipped.
+	Schematic interface {
+		Schema() Checkable
+	}
+
+	// ContextChecker is implemented by constraints of other packages that contain other constraints, so the options
+	// of a CheckWith call reach the nested constraints. CheckWithContext checks them with ctx.Check.
+	ContextChecker interface {
+		CheckWithContext(ctx *CheckContext) error
+	}
+
+	// contextChecker is ContextChecker for the constraints of this package.
+	contextChecker interface {
+		checkWith(ctx *CheckContext) error
+	}
+)
+
+// CheckWith checks c like c.Check() but with opts applied, without changing any global setting. Like Check it sets
+// the values of the constraints in c, so c must not be checked by several goroutines at once.
+func CheckWith(c Checkable, opts ...CheckOption) error {
+	ctx := new(CheckContext)
+	for _, opt := range opts {
+		opt(ctx)
+	}
+
+	if !ctx.observed() {
+		return ctx.check(c)
+	}
+
+	start := time.Now()
+	ctx.checkStarted(c)
+	err := ctx.check(c)
+	ctx.checkFinished(c, err, time.Since(start))
+
+	return err
+}
+
+// WithSemantic overrides DefaultCheckSemantic for the call. Constraints built with an explicit semantic, by First,
+// All or Constraints, keep it.
+func WithSemantic(semantic CheckSemantic) CheckOption {
+	return func(ctx *CheckContext) {
+		ctx.semantic = semantic
+		ctx.semanticSet = true
+	}
+}
+
+// WithMask checks only the fields at paths and the fields nested in them, paths are field names joined by dots such as
+// "address.city". Fields on the way to a path are checked too, nested values are reached through Schematic instead of
+// Validate. Only Field constraints are filtered, other constraints in a Schema such as Func always run.
+func WithMask(paths ...string) CheckOption {
+	return func(ctx *CheckContext) {
+		ctx.mask = valueSet(paths)
+	}
+}
+
+// MergePatchPaths returns the paths of the keys present in a JSON merge patch (RFC 7396) document, for use with
+// WithMask. Objects are followed into their keys, any other value, including null and arrays, is a path of its own.
+func MergePatchPaths(doc []byte) ([]string, error) {
+	var patch map[string]any
+	if err := json.Unmarshal(doc, &patch); err != nil {
+		return nil, jsonError(string(doc), err)
+	}
+
+	var paths []string
+	var walk func(prefix string, obj map[string]any)
+	walk = func(prefix string, obj map[string]any) {
+		for key, value := range obj {
+			if nested, ok := value.(map[string]any); ok && len(nested) > 0 {
+				walk(prefix+key+".", nested)
+			} else {
+				paths = append(paths, prefix+key)
+			}
+		}
+	}
+	walk("", patch)
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// Check checks c with the options of ctx, ctx may be nil.
+func (ctx *CheckContext) Check(c Checkable) error {
+	return ctx.check(c)
+}
+
+// check checks c with ctx, ctx may be nil which is the same as a plain Check.
+func (ctx *CheckContext) check(c Checkable) error {
+	if ctx != nil && ctx.tracer != nil {
+		return ctx.tracer.check(ctx, c)
+	}
+
+	return ctx.checkUntraced(c)
+}
+
+func (ctx *CheckContext) checkUntraced(c Checkable) error {
+	switch cc := c.(type) {
+	case contextChecker:
+		return cc.checkWith(ctx)
+	case ContextChecker:
+		return cc.CheckWithContext(ctx)
+	}
+
+	err := ctx.own(c, c.Check())
+	if err != nil && ctx.observed() {
+		ctx.constraintFailed(c, err)
+	}
+
+	return err
+}
+
+// validate checks a Validatable or Schematic value. Validate is preferred unless ctx has options for nested fields,
+// a value that is only Schematic is checked through its schema with ctx.
+func (ctx *CheckContext) validate(value any) error {
+	if s, ok := value.(Schematic); ok && ctx != nil {
+		if _, validatable := value.(Validatable); !validatable || ctx.nested() {
+			return ctx.check(s.Schema())
+		}
+	}
+
+	switch t := value.(type) {
+	case Validatable:
+		if ctx != nil && ctx.tracer != nil {
+			return ctx.tracer.record(ctx, "Validate", nil, t.Validate)
+		}
+		return t.Validate()
+	case Schematic:
+		return t.Schema().Check()
+	}
+
+	return nil
+}
+
+// nested reports whether ctx has options that must reach the fields of nested values.
+func (ctx *CheckContext) nested() bool {
+	return ctx.mask != nil || ctx.groups != nil || ctx.warnings != nil || ctx.tracer != nil || len(ctx.observers) > 0
+}
+
+// field returns the context for checking the field name and whether the field is checked at all.
+func (ctx *CheckContext) field(name string) (*CheckContext, bool) {
+	if ctx == nil {
+		return nil, true
+	}
+
+	fc := *ctx
+	fc.path = joinPath(ctx.path, name)
+
+	if ctx.mask == nil {
+		return &fc, true
+	}
+
+	for p := maskPath(fc.path); ; {
+		if ctx.mask[p] {
+			// the whole field is in the mask, so are its nested fields
+			fc.mask = nil
+			return &fc, true
+		}
+
+		i := strings.LastIndexByte(p, '.')
+		if i < 0 {
+			break
+		}
+		p = p[:i]
+	}
+
+	prefix := maskPath(fc.path) + "."
+	for p := range ctx.mask {
+		if strings.HasPrefix(p, prefix) {
+			return &fc, true
+		}
+	}
+
+	return nil, false
+}
+
+// item returns the context for checking the element i of a slice, its path ends in "#i" like the key of its errors.
+func (ctx *CheckContext) item(i int) *CheckContext {
+	if ctx == nil {
+		return nil
+	}
+
+	ic := *ctx
+	ic.path = joinPath(ctx.path, fmt.Sprintf("#%d", i))
+	return &ic
+}
+
+// maskPath returns path without the element indexes, a mask names fields and not elements.
+func maskPath(path string) string {
+	if !strings.Contains(path, "#") {
+		return path
+	}
+
+	parts := strings.Split(path, ".")
+	kept := parts[:0]
+	for _, p := range parts {
+		if !strings.HasPrefix(p, "#") {
+			kept = append(kept, p)
+		}
+	}
+
+	return strings.Join(kept, ".")
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func (ctx *CheckContext) defaultSemantic() CheckSemantic {
+	if ctx != nil && ctx.semanticSet {
+		return ctx.semantic
+	}
+
+	return DefaultCheckSemantic
+}
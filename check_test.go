@@ -0,0 +1,169 @@
+package vee
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCheckWithSemantic(t *testing.T) {
+	defer func(s CheckSemantic) { DefaultCheckSemantic = s }(DefaultCheckSemantic)
+	DefaultCheckSemantic = CheckSemanticFirst
+
+	schema := Schema(
+		Field("name", "", NotBlank(), StrMinLen(2)),
+		Field("code", "x", First(StrMinLen(2), NotIn(map[string]bool{"x": true}))),
+		Field("tags", []string{"", ""}, Each[[]string](NotBlank())),
+	)
+
+	if err := schema.Check(); len(collectErrors(err)) != 1 {
+		t.Errorf("error(%v), should have 1 error", err)
+	}
+
+	// name has 2 errors, code keeps its explicit First semantic and tags has an error per item
+	if err := CheckWith(schema, WithSemantic(CheckSemanticAll)); len(collectErrors(err)) != 5 {
+		t.Errorf("error(%v), should have 5 errors", err)
+	}
+
+	if err := CheckWith(schema); len(collectErrors(err)) != 1 {
+		t.Errorf("error(%v), should have 1 error", err)
+	}
+}
+
+type (
+	profile struct {
+		Name    string
+		Email   string
+		Address address
+	}
+
+	address struct {
+		Street string
+		City   string
+	}
+)
+
+func (p profile) Schema() Checkable {
+	return Schema(
+		Field("name", p.Name, NotBlank()),
+		Field("email", p.Email, NotBlank(), Email()),
+		Field("address", p.Address),
+	)
+}
+
+func (p profile) Validate() error {
+	return p.Schema().Check()
+}
+
+func (a address) Schema() Checkable {
+	return Schema(
+		Field("street", a.Street, NotBlank()),
+		Field("city", a.City, NotBlank()),
+	)
+}
+
+func (a address) Validate() error {
+	return a.Schema().Check()
+}
+
+// schemaAddress is only Schematic, so CheckWith always reaches its fields.
+type schemaAddress address
+
+func (a schemaAddress) Schema() Checkable {
+	return Schema(address(a).Schema(), Func(func() error { return nil }))
+}
+
+// shipment checks more in Validate than in its schema.
+type shipment struct {
+	Weight  int
+	Express bool
+}
+
+func (s shipment) Schema() Checkable {
+	return Schema(Field("weight", s.Weight, Positive[int]()))
+}
+
+func (s shipment) Validate() error {
+	if err := s.Schema().Check(); err != nil {
+		return err
+	}
+
+	if s.Express && s.Weight > 30 {
+		return errors.New("express shipments must weigh 30 at most")
+	}
+
+	return nil
+}
+
+func TestSchematicValidate(t *testing.T) {
+	heavy := shipment{Weight: 40, Express: true}
+	if err := CheckWith(Value(heavy)); err == nil {
+		t.Error("should run Validate without a mask but got nil")
+	}
+
+	if err := CheckWith(Value(heavy), WithSemantic(CheckSemanticAll)); err == nil {
+		t.Error("should run Validate with a semantic but got nil")
+	}
+
+	for name, opt := range map[string]CheckOption{
+		"mask":     WithMask("weight"),
+		"groups":   WithGroups("update"),
+		"warnings": CollectWarnings(new(ErrList)),
+		"trace":    WithTrace(new(Trace)),
+		"observer": WithObserver(NopObserver{}),
+	} {
+		if err := CheckWith(Value(heavy), opt); err != nil {
+			t.Errorf("error(%v), should check the schema with %s but got error", err, name)
+		}
+	}
+}
+
+func TestMask(t *testing.T) {
+	defer func(s CheckSemantic) { DefaultCheckSemantic = s }(DefaultCheckSemantic)
+	DefaultCheckSemantic = CheckSemanticAll
+
+	patch := profile{Email: "bob@example.com", Address: address{City: "Ottawa"}}
+
+	var tests = []struct {
+		name   string
+		paths  []string
+		errors int
+	}{
+		{"no mask", nil, 2},
+		{"present fields", []string{"email", "address.city"}, 0},
+		{"missing field", []string{"name", "email"}, 1},
+		{"whole nested value", []string{"address"}, 1},
+		{"nested path", []string{"address.street"}, 1},
+		{"empty mask", []string{}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var opts []CheckOption
+			if test.paths != nil {
+				opts = append(opts, WithMask(test.paths...))
+			}
+
+			err := CheckWith(patch.Schema(), opts...)
+			if n := len(collectErrors(err)); n != test.errors {
+				t.Errorf("error(%v), should have %d errors but got %d", err, test.errors, n)
+			}
+		})
+	}
+
+	paths, err := MergePatchPaths([]byte(`{"email": "bob@example.com", "address": {"city": "Ottawa"}, "tags": null}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(paths, ","); got != "address.city,email,tags" {
+		t.Errorf("paths should be %q but got %q", "address.city,email,tags", got)
+	}
+
+	if err := CheckWith(Value(patch), WithMask(paths...)); err != nil {
+		t.Errorf("error(%v), should get nil but got error", err)
+	}
+
+	if _, err := MergePatchPaths([]byte(`[1]`)); ErrorCode(err) != CodeJSON {
+		t.Errorf("error(%v), should have code %q", err, CodeJSON)
+	}
+}
@@ -0,0 +1,417 @@
+package vee
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	CodeLuhn         = "luhn"
+	CodeCardNumber   = "card_number"
+	CodeCardBrand    = "card_brand"
+	CodeIBAN         = "iban"
+	CodeIBANChecksum = "iban_checksum"
+	CodeBIC          = "bic"
+	CodeISBN         = "isbn"
+	CodeEAN          = "ean"
+	CodeUPC          = "upc"
+	CodeCheckDigit   = "check_digit"
+	CodeIBANCountry  = "iban_country"
+	CodeIBANLength   = "iban_length"
+)
+
+const (
+	cardNumberMinLen = 12
+	cardNumberMaxLen = 19
+	ibanPrefixLen    = 4
+)
+
+const (
+	CardBrandUnknown    CardBrand = ""
+	CardBrandVisa       CardBrand = "visa"
+	CardBrandMastercard CardBrand = "mastercard"
+	CardBrandAmex       CardBrand = "amex"
+	CardBrandDiscover   CardBrand = "discover"
+	CardBrandJCB        CardBrand = "jcb"
+	CardBrandDiners     CardBrand = "diners"
+	CardBrandUnionPay   CardBrand = "unionpay"
+	CardBrandMaestro    CardBrand = "maestro"
+)
+
+type (
+	CardBrand string
+
+	LuhnConstraint struct {
+		Value string
+	}
+
+	CardNumberConstraint struct {
+		Value  string
+		Brands map[CardBrand]bool
+	}
+
+	IBANConstraint struct {
+		Value string
+	}
+
+	BICConstraint struct {
+		Value string
+	}
+
+	ISBNConstraint struct {
+		Value   string
+		Lengths []int
+	}
+
+	EANConstraint struct {
+		Value  string
+		Code   string
+		Length int
+	}
+
+	cardRange struct {
+		brand      CardBrand
+		prefixFrom int
+		prefixTo   int
+		minLen     int
+		maxLen     int
+	}
+)
+
+var (
+	luhnError           = ConstraintError(CodeLuhn, "failed the Luhn checksum")
+	invalidCardError    = ConstraintError(CodeCardNumber, "invalid card number")
+	cardBrandError      = ConstraintError(CodeCardBrand, "card brand is not accepted")
+	invalidIBANError    = ConstraintError(CodeIBAN, "invalid IBAN")
+	ibanCountryError    = ConstraintError(CodeIBANCountry, "invalid IBAN, unknown country")
+	ibanLengthError     = ConstraintError(CodeIBANLength, "invalid IBAN, wrong length for country")
+	ibanChecksumError   = ConstraintError(CodeIBANChecksum, "invalid IBAN, wrong check digits")
+	invalidBICError     = ConstraintError(CodeBIC, "invalid BIC")
+	invalidISBNError    = ConstraintError(CodeISBN, "invalid ISBN")
+	invalidCheckDigit   = ConstraintError(CodeCheckDigit, "wrong check digit")
+	invalidEANError     = ConstraintError(CodeEAN, "invalid EAN-13")
+	invalidUPCError     = ConstraintError(CodeUPC, "invalid UPC-A")
+	cardNumberSeparator = strings.NewReplacer(" ", "", "-", "")
+)
+
+// cardRanges is ordered so that narrower prefixes come before the wider ones they overlap.
+var cardRanges = []cardRange{
+	{CardBrandAmex, 34, 34, 15, 15},
+	{CardBrandAmex, 37, 37, 15, 15},
+	{CardBrandDiners, 300, 305, 14, 19},
+	{CardBrandDiners, 36, 36, 14, 19},
+	{CardBrandDiners, 38, 39, 14, 19},
+	{CardBrandJCB, 3528, 3589, 16, 19},
+	{CardBrandVisa, 4, 4, 13, 19},
+	{CardBrandMastercard, 51, 55, 16, 16},
+	{CardBrandMastercard, 2221, 2720, 16, 16},
+	{CardBrandDiscover, 6011, 6011, 16, 19},
+	{CardBrandDiscover, 622126, 622925, 16, 19},
+	{CardBrandDiscover, 644, 649, 16, 19},
+	{CardBrandDiscover, 65, 65, 16, 19},
+	{CardBrandUnionPay, 62, 62, 16, 19},
+	{CardBrandMaestro, 50, 50, 12, 19},
+	{CardBrandMaestro, 56, 58, 12, 19},
+	{CardBrandMaestro, 6304, 6304, 12, 19},
+	{CardBrandMaestro, 6759, 6759, 12, 19},
+	{CardBrandMaestro, 6761, 6763, 12, 19},
+}
+
+// ibanLengths holds the IBAN length of each country in the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// Luhn checks a string of digits against the Luhn (mod 10) checksum.
+func Luhn() CheckableValue[string] {
+	return new(LuhnConstraint)
+}
+
+// CardNumber checks a payment card number of 12 to 19 digits, spaces and hyphens are ignored. The number must pass
+// the Luhn checksum and match the prefix and length of a known brand, if brands are given it must be one of them.
+// Errors never include the number.
+func CardNumber(brands ...CardBrand) CheckableValue[string] {
+	c := new(CardNumberConstraint)
+	if len(brands) > 0 {
+		c.Brands = make(map[CardBrand]bool, len(brands))
+		for _, b := range brands {
+			c.Brands[b] = true
+		}
+	}
+
+	return c
+}
+
+// CardBrandOf detects the brand of a card number from its prefix and length, it does not check the checksum.
+func CardBrandOf(number string) CardBrand {
+	number = cardNumberSeparator.Replace(number)
+	if !isDigits(number) {
+		return CardBrandUnknown
+	}
+
+	for _, r := range cardRanges {
+		digits := len(strconv.Itoa(r.prefixFrom))
+		if len(number) < digits || len(number) < r.minLen || len(number) > r.maxLen {
+			continue
+		}
+
+		prefix, _ := strconv.Atoi(number[:digits])
+		if prefix >= r.prefixFrom && prefix <= r.prefixTo {
+			return r.brand
+		}
+	}
+
+	return CardBrandUnknown
+}
+
+// IBAN checks an international bank account number, spaces are ignored. The length must match the country and the
+// check digits must pass the ISO 7064 mod 97-10 checksum.
+func IBAN() CheckableValue[string] {
+	return new(IBANConstraint)
+}
+
+// BIC checks an 8 or 11 character BIC (SWIFT) code.
+func BIC() CheckableValue[string] {
+	return new(BICConstraint)
+}
+
+// ISBN checks an ISBN-10 or ISBN-13, hyphens and spaces are ignored.
+func ISBN() CheckableValue[string] {
+	return &ISBNConstraint{
+		Lengths: []int{10, 13},
+	}
+}
+
+func ISBN10() CheckableValue[string] {
+	return &ISBNConstraint{
+		Lengths: []int{10},
+	}
+}
+
+func ISBN13() CheckableValue[string] {
+	return &ISBNConstraint{
+		Lengths: []int{13},
+	}
+}
+
+func EAN13() CheckableValue[string] {
+	return &EANConstraint{
+		Code:   CodeEAN,
+		Length: 13,
+	}
+}
+
+// UPC checks a 12 digit UPC-A code.
+func UPC() CheckableValue[string] {
+	return &EANConstraint{
+		Code:   CodeUPC,
+		Length: 12,
+	}
+}
+
+func (c *LuhnConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *LuhnConstraint) Check() error {
+	if c.Value == "" || !isDigits(c.Value) || !luhnValid(c.Value) {
+		return luhnError
+	}
+
+	return nil
+}
+
+func (c *CardNumberConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *CardNumberConstraint) Check() error {
+	number := cardNumberSeparator.Replace(c.Value)
+	if len(number) < cardNumberMinLen || len(number) > cardNumberMaxLen || !isDigits(number) {
+		return invalidCardError
+	} else if !luhnValid(number) {
+		return luhnError
+	}
+
+	brand := CardBrandOf(number)
+	if brand == CardBrandUnknown {
+		return invalidCardError
+	} else if c.Brands != nil && !c.Brands[brand] {
+		return cardBrandError
+	}
+
+	return nil
+}
+
+func (c *IBANConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *IBANConstraint) Check() error {
+	iban := strings.ToUpper(strings.ReplaceAll(c.Value, " ", ""))
+	if len(iban) < ibanPrefixLen || !isUpperAlnum(iban) || !isUpperAlpha(iban[:2]) || !isDigits(iban[2:4]) {
+		return invalidIBANError
+	}
+
+	length, ok := ibanLengths[iban[:2]]
+	if !ok {
+		return ibanCountryError
+	} else if len(iban) != length {
+		return ibanLengthError
+	}
+
+	// move the country code and check digits to the end and read letters as 10 to 35
+	rem := 0
+	for _, b := range []byte(iban[ibanPrefixLen:] + iban[:ibanPrefixLen]) {
+		if b >= 'A' {
+			rem = (rem*100 + int(b-'A'+10)) % 97
+		} else {
+			rem = (rem*10 + int(b-'0')) % 97
+		}
+	}
+
+	if rem != 1 {
+		return ibanChecksumError
+	}
+
+	return nil
+}
+
+func (c *BICConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *BICConstraint) Check() error {
+	v := c.Value
+	if (len(v) != 8 && len(v) != 11) || !isUpperAlpha(v[:6]) || !isUpperAlnum(v[6:]) {
+		return invalidBICError
+	}
+
+	return nil
+}
+
+func (c *ISBNConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *ISBNConstraint) Check() error {
+	isbn := cardNumberSeparator.Replace(c.Value)
+	for _, l := range c.Lengths {
+		if len(isbn) != l {
+			continue
+		}
+
+		if l == 10 {
+			if !isDigits(isbn[:9]) || !(isDigits(isbn[9:]) || isbn[9] == 'X') {
+				return invalidISBNError
+			}
+			if !isbn10Valid(isbn) {
+				return invalidCheckDigit
+			}
+			return nil
+		}
+
+		if !isDigits(isbn) || !(strings.HasPrefix(isbn, "978") || strings.HasPrefix(isbn, "979")) {
+			return invalidISBNError
+		}
+		if !eanValid(isbn) {
+			return invalidCheckDigit
+		}
+		return nil
+	}
+
+	return invalidISBNError
+}
+
+func (c *EANConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *EANConstraint) Check() error {
+	if len(c.Value) != c.Length || !isDigits(c.Value) {
+		if c.Code == CodeUPC {
+			return invalidUPCError
+		}
+		return invalidEANError
+	}
+
+	if !eanValid(c.Value) {
+		return invalidCheckDigit
+	}
+
+	return nil
+}
+
+func luhnValid(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+func isbn10Valid(isbn string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		d := 10
+		if isbn[i] != 'X' {
+			d = int(isbn[i] - '0')
+		}
+		sum += d * (10 - i)
+	}
+
+	return sum%11 == 0
+}
+
+// eanValid checks the GTIN check digit, weights alternate 3 and 1 from the right starting at the digit before the
+// check digit, which covers EAN-13, ISBN-13 and UPC-A.
+func eanValid(code string) bool {
+	sum := 0
+	for i := len(code) - 1; i >= 0; i-- {
+		d := int(code[i] - '0')
+		if (len(code)-1-i)%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+func isUpperAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isUpperAlnum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !(s[i] >= 'A' && s[i] <= 'Z' || s[i] >= '0' && s[i] <= '9') {
+			return false
+		}
+	}
+
+	return true
+}
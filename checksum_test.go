@@ -0,0 +1,47 @@
+package vee
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChecksums(t *testing.T) {
+	var tests = []testCase{
+		{"luhn", Value("79927398713", Luhn()), "", false},
+		{"luhn failed", Value("79927398710", Luhn()), CodeLuhn, true},
+		{"visa", Value("4111 1111 1111 1111", CardNumber()), "", false},
+		{"mastercard 2 series", Value("2223003122003222", CardNumber(CardBrandMastercard)), "", false},
+		{"amex", Value("3782-822463-10005", CardNumber()), "", false},
+		{"brand not accepted", Value("378282246310005", CardNumber(CardBrandVisa, CardBrandMastercard)), CodeCardBrand, true},
+		{"card checksum", Value("4111111111111112", CardNumber()), CodeLuhn, true},
+		{"card too short", Value("4111111", CardNumber()), CodeCardNumber, true},
+		{"iban", Value("GB82 WEST 1234 5698 7654 32", IBAN()), "", false},
+		{"iban lower case", Value("de89370400440532013000", IBAN()), "", false},
+		{"iban check digits", Value("GB83WEST12345698765432", IBAN()), CodeIBANChecksum, true},
+		{"iban length", Value("GB82WEST123456987654", IBAN()), CodeIBANLength, true},
+		{"iban country", Value("ZZ82WEST12345698765432", IBAN()), CodeIBANCountry, true},
+		{"bic", Value("DEUTDEFF", BIC()), "", false},
+		{"bic with branch", Value("DEUTDEFF500", BIC()), "", false},
+		{"invalid bic", Value("DEUT1EFF", BIC()), CodeBIC, true},
+		{"isbn 10", Value("0-306-40615-2", ISBN()), "", false},
+		{"isbn 10 with x", Value("080442957X", ISBN10()), "", false},
+		{"isbn 13", Value("978-0-306-40615-7", ISBN()), "", false},
+		{"isbn 13 check digit", Value("9780306406158", ISBN13()), CodeCheckDigit, true},
+		{"isbn 13 prefix", Value("4006381333931", ISBN13()), CodeISBN, true},
+		{"ean 13", Value("4006381333931", EAN13()), "", false},
+		{"ean 13 check digit", Value("4006381333932", EAN13()), CodeCheckDigit, true},
+		{"upc", Value("036000291452", UPC()), "", false},
+		{"upc length", Value("03600029145", UPC()), CodeUPC, true},
+	}
+
+	runCases(t, tests)
+
+	if brand := CardBrandOf("6011 1111 1111 1117"); brand != CardBrandDiscover {
+		t.Errorf("should detect %q but got %q", CardBrandDiscover, brand)
+	}
+
+	err := Field("card", "4111111111111112", CardNumber()).Check()
+	if err == nil || strings.Contains(err.Error(), "4111") {
+		t.Errorf("error(%v) should not include the card number", err)
+	}
+}
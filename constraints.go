@@ -2,6 +2,7 @@ package vee
 
 import (
 	"fmt"
+	"time"
 )
 
 type (
@@ -25,11 +26,18 @@ type (
 		Value       T
 		Constraints []CheckableValue[T]
 		Semantic    CheckSemantic
+
+		// implicit is set when Semantic was taken from DefaultCheckSemantic, so WithSemantic can override it.
+		implicit bool
+
+		// wrapped is set when a ValueConstraint already validates the value.
+		wrapped bool
 	}
 
 	FieldConstraint[T any] struct {
 		Constraint CheckableValue[T]
 		FieldName  string
+		Sensitive  bool
 	}
 
 	EachConstraint[T ~[]E, E any] struct {
@@ -67,10 +75,10 @@ var (
 )
 
 func Value[T any](value T, cons ...CheckableValue[T]) CheckableValue[T] {
-	c := Constraints(DefaultCheckSemantic, cons...)
-	c.SetValue(value)
+	c := defaultConstraints(cons)
+	c.wrapped = true
 	return &ValueConstraint[T]{
-		Value:      value,
+		Value:      c.Transform(value),
 		Constraint: c,
 	}
 }
@@ -86,20 +94,20 @@ func Field[T any](name string, value T, cons ...CheckableValue[T]) CheckableValu
 
 func Each[T ~[]E, E any](cons ...CheckableValue[E]) CheckableValue[T] {
 	return &EachConstraint[T, E]{
-		Constraint: Constraints(DefaultCheckSemantic, cons...),
+		Constraint: defaultConstraints(cons),
 	}
 }
 
 func If[T any](predicate func() bool, cons ...CheckableValue[T]) CheckableValue[T] {
 	return &IfConstraint[T]{
 		Predicate:  predicate,
-		Constraint: Constraints(DefaultCheckSemantic, cons...),
+		Constraint: defaultConstraints(cons),
 	}
 }
 
 func IfNotNil[T ~*E, E any](cons ...CheckableValue[E]) CheckableValue[T] {
 	return &IfNotNilConstraint[T, E]{
-		Constraint: Constraints(DefaultCheckSemantic, cons...),
+		Constraint: defaultConstraints(cons),
 	}
 }
 
@@ -110,6 +118,15 @@ func Constraints[T any](semantic CheckSemantic, cons ...CheckableValue[T]) Check
 	}
 }
 
+// defaultConstraints is like Constraints with DefaultCheckSemantic, the semantic can be overridden by WithSemantic.
+func defaultConstraints[T any](cons []CheckableValue[T]) *ValueConstraints[T] {
+	return &ValueConstraints[T]{
+		Constraints: cons,
+		Semantic:    DefaultCheckSemantic,
+		implicit:    true,
+	}
+}
+
 func First[T any](cons ...CheckableValue[T]) CheckableValue[T] {
 	return Constraints(CheckSemanticFirst, cons...)
 }
@@ -131,14 +148,19 @@ func (c *ValueConstraint[T]) SetValue(value T) {
 }
 
 func (c *ValueConstraint[T]) Check() error {
-	if t, ok := any(c.Value).(Validatable); ok {
-		err := t.Validate()
-		if err != nil {
-			return err
-		}
+	return c.checkWith(nil)
+}
+
+func (c *ValueConstraint[T]) traceName() string {
+	return ""
+}
+
+func (c *ValueConstraint[T]) checkWith(ctx *CheckContext) error {
+	if err := ctx.validate(c.Value); err != nil {
+		return err
 	}
 
-	err := c.Constraint.Check()
+	err := ctx.check(c.Constraint)
 	if err != nil {
 		return err
 	}
@@ -148,17 +170,48 @@ func (c *ValueConstraint[T]) Check() error {
 }
 
 func (c *ValueConstraints[T]) SetValue(value T) {
-	c.Value = value
+	c.Transform(value)
+}
+
+// Transform sets the value of each constraint in order, a Transformer changes the value seen by the ones after it.
+func (c *ValueConstraints[T]) Transform(value T) T {
 	for _, con := range c.Constraints {
-		con.SetValue(value)
+		if t, ok := con.(Transformer[T]); ok {
+			value = t.Transform(value)
+		} else {
+			con.SetValue(value)
+		}
 	}
+
+	c.Value = value
+	return value
 }
 
 func (c *ValueConstraints[T]) Check() error {
-	if c.Semantic == CheckSemanticAll {
+	return c.checkWith(nil)
+}
+
+func (c *ValueConstraints[T]) traceName() string {
+	switch {
+	case c.implicit:
+		return ""
+	case c.Semantic == CheckSemanticAll:
+		return "All"
+	default:
+		return "First"
+	}
+}
+
+func (c *ValueConstraints[T]) checkWith(ctx *CheckContext) error {
+	semantic := c.Semantic
+	if c.implicit {
+		semantic = ctx.defaultSemantic()
+	}
+
+	if semantic == CheckSemanticAll {
 		var e ErrList
 		for _, con := range c.Constraints {
-			err := con.Check()
+			err := ctx.check(con)
 			if err != nil {
 				e = append(e, err)
 			}
@@ -169,18 +222,19 @@ func (c *ValueConstraints[T]) Check() error {
 		}
 	} else {
 		for _, con := range c.Constraints {
-			err := con.Check()
+			err := ctx.check(con)
 			if err != nil {
 				return err
 			}
 		}
 	}
 
-	if t, ok := any(c.Value).(Validatable); ok {
-		err := t.Validate()
-		if err != nil {
-			return err
-		}
+	if c.wrapped {
+		return nil
+	}
+
+	if err := ctx.validate(c.Value); err != nil {
+		return err
 	}
 
 	return nil
@@ -191,7 +245,43 @@ func (c *FieldConstraint[T]) SetValue(value T) {
 }
 
 func (c *FieldConstraint[T]) Check() error {
-	err := c.Constraint.Check()
+	return c.checkWith(nil)
+}
+
+func (c *FieldConstraint[T]) fieldName() string {
+	return c.FieldName
+}
+
+func (c *FieldConstraint[T]) checkWith(ctx *CheckContext) error {
+	if c.Sensitive && ctx == nil {
+		// a context marks the messages that can be kept
+		ctx = new(CheckContext)
+	}
+
+	fctx, ok := ctx.field(c.FieldName)
+	if !ok {
+		ctx.skip("not in mask")
+		return nil
+	}
+	ctx = fctx
+	if c.Sensitive {
+		ctx.sensitive = true
+	}
+
+	var start time.Time
+	if ctx.observed() {
+		start = time.Now()
+	}
+
+	err := ctx.check(c.Constraint)
+	if c.Sensitive {
+		err = redact(err)
+	}
+
+	if ctx.observed() {
+		ctx.fieldChecked(err, time.Since(start))
+	}
+
 	if err != nil {
 		return FieldError(c.FieldName, err)
 	}
@@ -203,12 +293,16 @@ func (c *EachConstraint[T, E]) SetValue(value T) {
 }
 
 func (c *EachConstraint[T, E]) Check() error {
-	if DefaultCheckSemantic == CheckSemanticAll {
+	return c.checkWith(nil)
+}
+
+func (c *EachConstraint[T, E]) checkWith(ctx *CheckContext) error {
+	if ctx.defaultSemantic() == CheckSemanticAll {
 		var e ErrList
 		for i, item := range c.Value {
 			var ie ErrList
 			c.Constraint.SetValue(item)
-			err := c.Constraint.Check()
+			err := ctx.item(i).check(c.Constraint)
 			if err != nil {
 				ie = append(ie, err)
 			}
@@ -223,7 +317,7 @@ func (c *EachConstraint[T, E]) Check() error {
 	} else {
 		for i, item := range c.Value {
 			c.Constraint.SetValue(item)
-			err := c.Constraint.Check()
+			err := ctx.item(i).check(c.Constraint)
 			if err != nil {
 				return FieldError(fmt.Sprintf("#%d", i), err)
 			}
@@ -238,11 +332,16 @@ func (c *IfConstraint[T]) SetValue(value T) {
 }
 
 func (c *IfConstraint[T]) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *IfConstraint[T]) checkWith(ctx *CheckContext) error {
 	if c.Predicate() {
 		c.Constraint.SetValue(c.Value)
-		return c.Constraint.Check()
+		return ctx.check(c.Constraint)
 	}
 
+	ctx.skip("condition is false")
 	return nil
 }
 
@@ -251,19 +350,28 @@ func (c *IfNotNilConstraint[T, E]) SetValue(value T) {
 }
 
 func (c *IfNotNilConstraint[T, E]) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *IfNotNilConstraint[T, E]) checkWith(ctx *CheckContext) error {
 	if c.Value != nil {
 		c.Constraint.SetValue(*c.Value)
-		return c.Constraint.Check()
+		return ctx.check(c.Constraint)
 	}
 
+	ctx.skip("value is nil")
 	return nil
 }
 
 func (c *SchemaConstraint) Check() error {
-	if DefaultCheckSemantic == CheckSemanticAll {
+	return c.checkWith(nil)
+}
+
+func (c *SchemaConstraint) checkWith(ctx *CheckContext) error {
+	if ctx.defaultSemantic() == CheckSemanticAll {
 		var e ErrList
 		for _, con := range c.Constraints {
-			err := con.Check()
+			err := ctx.check(con)
 			if err != nil {
 				e = append(e, err)
 			}
@@ -273,7 +381,7 @@ func (c *SchemaConstraint) Check() error {
 		}
 	} else {
 		for _, con := range c.Constraints {
-			err := con.Check()
+			err := ctx.check(con)
 			if err != nil {
 				return err
 			}
@@ -285,3 +393,18 @@ func (c *SchemaConstraint) Check() error {
 func (c *FuncConstraint) Check() error {
 	return c.Func()
 }
+
+func (c *FuncConstraint) checkWith(ctx *CheckContext) error {
+	if !ctx.observed() {
+		return c.Func()
+	}
+
+	start := time.Now()
+	err := c.Func()
+	ctx.funcChecked(err, time.Since(start))
+	if err != nil {
+		ctx.constraintFailed(c, err)
+	}
+
+	return err
+}
@@ -0,0 +1,190 @@
+package vee
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+type (
+	BigNumber[T any] interface {
+		*big.Int | *big.Rat | *big.Float
+		Cmp(T) int
+	}
+
+	DecimalConstraint struct {
+		Value        string
+		MaxIntDigits int
+		MaxScale     int
+	}
+
+	DecimalRangeConstraint struct {
+		Value string
+		Min   *big.Rat
+		Max   *big.Rat
+	}
+
+	BigRangeConstraint[T BigNumber[T]] struct {
+		Value T
+		Min   T
+		Max   T
+	}
+)
+
+var (
+	invalidDecimalError = errors.New("is not a valid decimal number")
+)
+
+// Decimal checks that the value is a plain decimal number such as "-1234.50" with at most maxIntDigits digits
+// before the decimal point and maxScale digits after it, leading and trailing zeros are not counted.
+// A negative limit is not checked.
+func Decimal(maxIntDigits, maxScale int) CheckableValue[string] {
+	return &DecimalConstraint{
+		MaxIntDigits: maxIntDigits,
+		MaxScale:     maxScale,
+	}
+}
+
+// DecimalRange checks that the value is a decimal number between min and max inclusive, the bounds are decimal
+// strings and the comparison is exact. An empty bound is not checked.
+func DecimalRange(min, max string) CheckableValue[string] {
+	return &DecimalRangeConstraint{
+		Min: mustParseDecimalBound(min),
+		Max: mustParseDecimalBound(max),
+	}
+}
+
+func DecimalMin(min string) CheckableValue[string] {
+	return DecimalRange(min, "")
+}
+
+func DecimalMax(max string) CheckableValue[string] {
+	return DecimalRange("", max)
+}
+
+// BigRange checks a *big.Int, *big.Rat or *big.Float value between min and max inclusive, a nil bound is not
+// checked and a nil value passes, use Required to reject it.
+func BigRange[T BigNumber[T]](min, max T) CheckableValue[T] {
+	return &BigRangeConstraint[T]{
+		Min: min,
+		Max: max,
+	}
+}
+
+func BigMin[T BigNumber[T]](min T) CheckableValue[T] {
+	return BigRange[T](min, nil)
+}
+
+func BigMax[T BigNumber[T]](max T) CheckableValue[T] {
+	return BigRange[T](nil, max)
+}
+
+func (c *DecimalConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *DecimalConstraint) Check() error {
+	_, intDigits, scale, ok := parseDecimal(c.Value)
+	if !ok {
+		return invalidDecimalError
+	}
+
+	if c.MaxIntDigits >= 0 && intDigits > c.MaxIntDigits {
+		return fmt.Errorf("must have %d integer digits at most", c.MaxIntDigits)
+	} else if c.MaxScale >= 0 && scale > c.MaxScale {
+		return fmt.Errorf("must have %d decimal places at most", c.MaxScale)
+	}
+
+	return nil
+}
+
+func (c *DecimalRangeConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *DecimalRangeConstraint) Check() error {
+	r, _, _, ok := parseDecimal(c.Value)
+	if !ok {
+		return invalidDecimalError
+	}
+
+	if c.Max != nil && r.Cmp(c.Max) > 0 {
+		return fmt.Errorf("is greater than maximum %s", formatDecimal(c.Max))
+	} else if c.Min != nil && r.Cmp(c.Min) < 0 {
+		return fmt.Errorf("is less than minimum %s", formatDecimal(c.Min))
+	}
+
+	return nil
+}
+
+func (c *BigRangeConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *BigRangeConstraint[T]) Check() error {
+	if c.Value == nil {
+		return nil
+	}
+
+	if c.Max != nil && c.Value.Cmp(c.Max) > 0 {
+		return fmt.Errorf("is greater than maximum %v", c.Max)
+	} else if c.Min != nil && c.Value.Cmp(c.Min) < 0 {
+		return fmt.Errorf("is less than minimum %v", c.Min)
+	}
+
+	return nil
+}
+
+// parseDecimal parses s as [+-]digits[.digits] and returns its exact value with the number of significant integer
+// digits and decimal places.
+func parseDecimal(s string) (r *big.Rat, intDigits int, scale int, ok bool) {
+	digits := strings.TrimLeft(s, "+-")
+	if len(s)-len(digits) > 1 {
+		return nil, 0, 0, false
+	}
+
+	intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+	if intPart == "" || (hasPoint && fracPart == "") || !isDigits(intPart) || !isDigits(fracPart) {
+		return nil, 0, 0, false
+	}
+
+	r, ok = new(big.Rat).SetString(s)
+	if !ok {
+		return nil, 0, 0, false
+	}
+
+	return r, len(strings.TrimLeft(intPart, "0")), len(strings.TrimRight(fracPart, "0")), true
+}
+
+func mustParseDecimalBound(s string) *big.Rat {
+	if s == "" {
+		return nil
+	}
+
+	r, _, _, ok := parseDecimal(s)
+	if !ok {
+		panic(fmt.Sprintf("invalid decimal bound %q", s))
+	}
+
+	return r
+}
+
+func formatDecimal(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	s := r.FloatString(32)
+	return strings.TrimRight(s, "0")
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,29 @@
+package vee
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	var tests = []testCase{
+		{"valid decimal", Value("-1234.50", Decimal(4, 2)), "", false},
+		{"trailing zeros", Value("1.500", Decimal(4, 2)), "", false},
+		{"too many integer digits", Value("12345", Decimal(4, 2)), "", true},
+		{"too many decimal places", Value("0.125", Decimal(4, 2)), "", true},
+		{"unlimited", Value("123456789.123456789", Decimal(-1, -1)), "", false},
+		{"exponent", Value("1e3", Decimal(-1, -1)), "", true},
+		{"fraction", Value("1/3", Decimal(-1, -1)), "", true},
+		{"missing fraction", Value("1.", Decimal(-1, -1)), "", true},
+		{"in range", Value("0.10", DecimalRange("0.1", "100")), "", false},
+		{"below minimum", Value("0.0999999999999999999999", DecimalMin("0.1")), "", true},
+		{"above maximum", Value("100.0000000000000000001", DecimalMax("100")), "", true},
+		{"big int in range", Value(big.NewInt(5), BigRange(big.NewInt(1), big.NewInt(10))), "", false},
+		{"big int above maximum", Value(big.NewInt(11), BigMax(big.NewInt(10))), "", true},
+		{"big rat below minimum", Value(big.NewRat(1, 3), BigMin(big.NewRat(1, 2))), "", true},
+		{"big float in range", Value(big.NewFloat(2.5), BigRange(big.NewFloat(2.5), big.NewFloat(3))), "", false},
+		{"nil big int", Value[*big.Int](nil, BigMin(big.NewInt(1))), "", false},
+	}
+
+	runCases(t, tests)
+}
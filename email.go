@@ -0,0 +1,178 @@
+package vee
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+const DefaultEmailMaxLength = 254
+
+type (
+	EmailConstraint struct {
+		Value          string
+		MaxLength      int
+		RequireTLD     bool
+		DenyIPDomain   bool
+		AllowedDomains []string
+		DeniedDomains  []string
+	}
+
+	EmailOption func(*EmailConstraint)
+)
+
+var (
+	invalidEmailError   = errors.New("invalid email")
+	emailTLDError       = errors.New("invalid email, domain must have a top level domain")
+	emailIPDomainError  = errors.New("invalid email, domain cannot be an IP address")
+	emailLocalPartError = errors.New("invalid email, local part must have 64 characters at most")
+)
+
+// Email checks an addr-spec email address such as "a+b@example.museum" following RFC 5322 and RFC 6532,
+// international domain names are accepted and checked in their ASCII form. Display names, angle brackets and
+// comments are rejected. Addresses longer than DefaultEmailMaxLength are rejected unless EmailMaxLength is given.
+func Email(opts ...EmailOption) CheckableValue[string] {
+	c := &EmailConstraint{
+		MaxLength: DefaultEmailMaxLength,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func EmailMaxLength(max int) EmailOption {
+	return func(c *EmailConstraint) {
+		c.MaxLength = max
+	}
+}
+
+// EmailRequireTLD rejects domains without a top level domain, such as "user@localhost".
+func EmailRequireTLD() EmailOption {
+	return func(c *EmailConstraint) {
+		c.RequireTLD = true
+	}
+}
+
+// EmailNoIPDomain rejects IP literal domains, such as "user@[192.0.2.1]".
+func EmailNoIPDomain() EmailOption {
+	return func(c *EmailConstraint) {
+		c.DenyIPDomain = true
+	}
+}
+
+// EmailAllowDomains accepts the given domains only, a domain starting with "*." matches any subdomain of the rest.
+func EmailAllowDomains(domains ...string) EmailOption {
+	return func(c *EmailConstraint) {
+		c.AllowedDomains = asciiDomains(domains)
+	}
+}
+
+// EmailDenyDomains rejects the given domains, a domain starting with "*." matches any subdomain of the rest.
+func EmailDenyDomains(domains ...string) EmailOption {
+	return func(c *EmailConstraint) {
+		c.DeniedDomains = asciiDomains(domains)
+	}
+}
+
+func (c *EmailConstraint) quotesValue() {}
+
+func (c *EmailConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *EmailConstraint) Check() error {
+	if len(c.Value) > c.MaxLength {
+		return fmt.Errorf("invalid email, must have %d characters at most", c.MaxLength)
+	}
+
+	i := strings.LastIndexByte(c.Value, '@')
+	if i < 0 {
+		return invalidEmailError
+	}
+
+	local, domain := c.Value[:i], c.Value[i+1:]
+	if !isEmailLocalPart(local) {
+		return invalidEmailError
+	} else if len(local) > 64 {
+		return emailLocalPartError
+	}
+
+	if strings.HasPrefix(domain, "[") {
+		if c.DenyIPDomain {
+			return emailIPDomainError
+		} else if !isEmailDomainLiteral(domain) {
+			return invalidEmailError
+		}
+		domain = strings.ToLower(domain)
+	} else {
+		ascii, err := idna.Lookup.ToASCII(domain)
+		if err != nil || !isHostname(ascii) {
+			return invalidEmailError
+		} else if c.RequireTLD && !isFQDN(ascii) {
+			return emailTLDError
+		}
+		domain = strings.ToLower(ascii)
+	}
+
+	if c.AllowedDomains != nil && !matchHost(c.AllowedDomains, domain) {
+		return fmt.Errorf("email domain %s is not allowed", domain)
+	} else if c.DeniedDomains != nil && matchHost(c.DeniedDomains, domain) {
+		return fmt.Errorf("email domain %s is not allowed", domain)
+	}
+
+	return nil
+}
+
+// isEmailLocalPart checks a dot-atom or quoted-string local part, the domain is replaced so that net/mail parses
+// the local part on its own.
+func isEmailLocalPart(local string) bool {
+	if local == "" {
+		return false
+	}
+
+	if !strings.HasPrefix(local, `"`) && strings.ContainsAny(local, " \t()<>[]:;,\\\"") {
+		return false
+	}
+
+	addr, err := mail.ParseAddress(local + "@example.com")
+	return err == nil && addr.Name == ""
+}
+
+func isEmailDomainLiteral(domain string) bool {
+	literal, ok := strings.CutSuffix(strings.TrimPrefix(domain, "["), "]")
+	if !ok {
+		return false
+	}
+
+	if v6, ok := strings.CutPrefix(literal, "IPv6:"); ok {
+		addr, err := netip.ParseAddr(v6)
+		return err == nil && addr.Is6() && addr.Zone() == ""
+	}
+
+	addr, err := netip.ParseAddr(literal)
+	return err == nil && addr.Is4()
+}
+
+func asciiDomains(domains []string) []string {
+	ascii := make([]string, 0, len(domains))
+	for _, d := range domains {
+		wildcard := strings.HasPrefix(d, "*.")
+		a, err := idna.Lookup.ToASCII(strings.TrimPrefix(d, "*."))
+		if err != nil {
+			panic(fmt.Sprintf("invalid domain %q", d))
+		}
+
+		if wildcard {
+			a = "*." + a
+		}
+		ascii = append(ascii, a)
+	}
+
+	return ascii
+}
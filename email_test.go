@@ -0,0 +1,38 @@
+package vee
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmail(t *testing.T) {
+	var tests = []testCase{
+		{"plain", Value("test@test.com", Email()), "", false},
+		{"plus tag", Value("a+b@x.io", Email()), "", false},
+		{"long tld", Value("curator@example.museum", Email()), "", false},
+		{"longer tld", Value("dev@team.engineering", Email()), "", false},
+		{"quoted local part", Value(`"john doe"@example.com`, Email()), "", false},
+		{"idn domain", Value("info@bücher.de", Email()), "", false},
+		{"unicode local part", Value("josé@example.com", Email()), "", false},
+		{"ip literal", Value("root@[192.0.2.1]", Email()), "", false},
+		{"ipv6 literal", Value("root@[IPv6:2001:db8::1]", Email()), "", false},
+		{"no domain", Value("test@", Email()), "", true},
+		{"no at", Value("test.example.com", Email()), "", true},
+		{"consecutive dots", Value("a..b@example.com", Email()), "", true},
+		{"leading dot", Value(".a@example.com", Email()), "", true},
+		{"display name", Value("Bob <bob@example.com>", Email()), "", true},
+		{"comment", Value("bob(work)@example.com", Email()), "", true},
+		{"underscore domain", Value("a@exa_mple.com", Email()), "", true},
+		{"no tld allowed", Value("root@localhost", Email()), "", false},
+		{"no tld", Value("root@localhost", Email(EmailRequireTLD())), "", true},
+		{"ip literal denied", Value("root@[192.0.2.1]", Email(EmailNoIPDomain())), "", true},
+		{"too long", Value(strings.Repeat("a", 64)+"@"+strings.Repeat("b", 186)+".com", Email()), "", true},
+		{"local part too long", Value(strings.Repeat("a", 65)+"@example.com", Email()), "", true},
+		{"max length", Value("abc@example.com", Email(EmailMaxLength(10))), "", true},
+		{"allowed domain", Value("a@mail.example.com", Email(EmailAllowDomains("*.example.com"))), "", false},
+		{"not allowed domain", Value("a@example.org", Email(EmailAllowDomains("example.com"))), "", true},
+		{"denied domain", Value("a@Mailinator.com", Email(EmailDenyDomains("mailinator.com"))), "", true},
+	}
+
+	runCases(t, tests)
+}
@@ -0,0 +1,185 @@
+package vee
+
+import (
+	"fmt"
+	"math"
+)
+
+const earthRadiusMeters = 6371008.8
+
+type (
+	LatLng struct {
+		Lat float64 `json:"lat"`
+		Lng float64 `json:"lng"`
+	}
+
+	BoundingBox struct {
+		SouthWest LatLng `json:"south_west"`
+		NorthEast LatLng `json:"north_east"`
+	}
+
+	BoundingBoxConstraint struct {
+		Value LatLng
+		Box   BoundingBox
+	}
+
+	RadiusConstraint struct {
+		Value  LatLng
+		Center LatLng
+		Meters float64
+	}
+
+	PolygonConstraint struct {
+		Value   LatLng
+		Polygon []LatLng
+	}
+)
+
+// Latitude checks a finite latitude between -90 and 90 degrees.
+func Latitude() CheckableValue[float64] {
+	return First(Finite[float64](), Range(-90.0, 90.0))
+}
+
+// Longitude checks a finite longitude between -180 and 180 degrees.
+func Longitude() CheckableValue[float64] {
+	return First(Finite[float64](), Range(-180.0, 180.0))
+}
+
+// WithinBoundingBox checks that the point is inside box, edges included. A box whose south west longitude is greater
+// than its north east longitude crosses the antimeridian.
+func WithinBoundingBox(box BoundingBox) CheckableValue[LatLng] {
+	return &BoundingBoxConstraint{
+		Box: box,
+	}
+}
+
+// WithinRadius checks that the great-circle (haversine) distance from center to the point is at most meters.
+func WithinRadius(center LatLng, meters float64) CheckableValue[LatLng] {
+	return &RadiusConstraint{
+		Center: center,
+		Meters: meters,
+	}
+}
+
+// WithinPolygon checks that the point is inside polygon, the polygon is given as its vertices in order and is closed
+// implicitly. Edges are treated as straight lines in latitude and longitude, which is accurate enough for service
+// areas that do not span large distances or cross the antimeridian.
+func WithinPolygon(polygon []LatLng) CheckableValue[LatLng] {
+	if len(polygon) < 3 {
+		panic("polygon must have 3 vertices at least")
+	}
+
+	return &PolygonConstraint{
+		Polygon: polygon,
+	}
+}
+
+// Distance returns the great-circle distance in meters between p and o.
+func (p LatLng) Distance(o LatLng) float64 {
+	lat1, lat2 := radians(p.Lat), radians(o.Lat)
+	dLat, dLng := lat2-lat1, radians(o.Lng-p.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func (p LatLng) Validate() error {
+	return Schema(
+		Field("lat", p.Lat, Latitude()),
+		Field("lng", p.Lng, Longitude()),
+	).Check()
+}
+
+func (c *BoundingBoxConstraint) SetValue(value LatLng) {
+	c.Value = value
+}
+
+func (c *BoundingBoxConstraint) Check() error {
+	sw, ne, p := c.Box.SouthWest, c.Box.NorthEast, c.Value
+
+	inLng := p.Lng >= sw.Lng && p.Lng <= ne.Lng
+	if sw.Lng > ne.Lng {
+		inLng = p.Lng >= sw.Lng || p.Lng <= ne.Lng
+	}
+
+	if !(p.Lat >= sw.Lat && p.Lat <= ne.Lat) || !inLng {
+		return fmt.Errorf("is outside the bounding box from (%v, %v) to (%v, %v)", sw.Lat, sw.Lng, ne.Lat, ne.Lng)
+	}
+
+	return nil
+}
+
+func (c *RadiusConstraint) quotesValue() {}
+
+func (c *RadiusConstraint) SetValue(value LatLng) {
+	c.Value = value
+}
+
+func (c *RadiusConstraint) Check() error {
+	d := c.Center.Distance(c.Value)
+	if !(d <= c.Meters) {
+		return fmt.Errorf("is %s from (%v, %v), must be within %s", formatMeters(d), c.Center.Lat, c.Center.Lng,
+			formatMeters(c.Meters))
+	}
+
+	return nil
+}
+
+func (c *PolygonConstraint) quotesValue() {}
+
+func (c *PolygonConstraint) SetValue(value LatLng) {
+	c.Value = value
+}
+
+func (c *PolygonConstraint) Check() error {
+	p := c.Value
+	inside := false
+	for i, j := 0, len(c.Polygon)-1; i < len(c.Polygon); j, i = i, i+1 {
+		a, b := c.Polygon[i], c.Polygon[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+
+	if !inside {
+		return fmt.Errorf("is outside the area, %s from its boundary", formatMeters(c.boundaryDistance()))
+	}
+
+	return nil
+}
+
+// boundaryDistance approximates the distance from the point to the nearest polygon edge, using an equirectangular
+// projection centered on the point.
+func (c *PolygonConstraint) boundaryDistance() float64 {
+	k := math.Cos(radians(c.Value.Lat))
+	project := func(q LatLng) (float64, float64) {
+		return radians(q.Lng-c.Value.Lng) * k * earthRadiusMeters, radians(q.Lat-c.Value.Lat) * earthRadiusMeters
+	}
+
+	d := math.Inf(1)
+	for i, j := 0, len(c.Polygon)-1; i < len(c.Polygon); j, i = i, i+1 {
+		ax, ay := project(c.Polygon[j])
+		bx, by := project(c.Polygon[i])
+		dx, dy := bx-ax, by-ay
+
+		t := 0.0
+		if l := dx*dx + dy*dy; l > 0 {
+			t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/l))
+		}
+		d = math.Min(d, math.Hypot(ax+t*dx, ay+t*dy))
+	}
+
+	return d
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func formatMeters(m float64) string {
+	if m < 1000 {
+		return fmt.Sprintf("%.0f m", m)
+	}
+
+	return fmt.Sprintf("%.1f km", m/1000)
+}
@@ -0,0 +1,43 @@
+package vee
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestGeo(t *testing.T) {
+	berlin := LatLng{Lat: 52.5200, Lng: 13.4050}
+	potsdam := LatLng{Lat: 52.3906, Lng: 13.0645}
+	area := []LatLng{{52.3, 13.0}, {52.3, 13.8}, {52.7, 13.8}, {52.7, 13.0}}
+	pacific := BoundingBox{SouthWest: LatLng{Lat: -30, Lng: 170}, NorthEast: LatLng{Lat: 30, Lng: -170}}
+
+	var tests = []testCase{
+		{"latitude", Value(52.52, Latitude()), "", false},
+		{"latitude out of range", Value(90.5, Latitude()), "", true},
+		{"NaN latitude", Value(math.NaN(), Latitude()), "", true},
+		{"longitude out of range", Value(-180.5, Longitude()), "", true},
+		{"lat lng", Value(berlin), "", false},
+		{"invalid lat lng", Value(LatLng{Lat: 91, Lng: 0}), "", true},
+		{"within bounding box", Value(berlin, WithinBoundingBox(BoundingBox{potsdam, LatLng{53, 14}})), "", false},
+		{"outside bounding box", Value(potsdam, WithinBoundingBox(BoundingBox{berlin, LatLng{53, 14}})), "", true},
+		{"within antimeridian box", Value(LatLng{Lat: 0, Lng: 179}, WithinBoundingBox(pacific)), "", false},
+		{"outside antimeridian box", Value(LatLng{Lat: 0, Lng: 0}, WithinBoundingBox(pacific)), "", true},
+		{"within radius", Value(potsdam, WithinRadius(berlin, 30000)), "", false},
+		{"outside radius", Value(potsdam, WithinRadius(berlin, 20000)), "", true},
+		{"within polygon", Value(berlin, WithinPolygon(area)), "", false},
+		{"outside polygon", Value(LatLng{Lat: 52.2, Lng: 13.4}, WithinPolygon(area)), "", true},
+	}
+
+	runCases(t, tests)
+
+	err := Value(potsdam, WithinRadius(berlin, 20000)).Check()
+	if err == nil || !strings.Contains(err.Error(), "27.2 km") {
+		t.Errorf("error(%v) should include the distance", err)
+	}
+
+	err = Value(LatLng{Lat: 52.2, Lng: 13.4}, WithinPolygon(area)).Check()
+	if err == nil || !strings.Contains(err.Error(), "11.1 km") {
+		t.Errorf("error(%v) should include the distance to the boundary", err)
+	}
+}
@@ -1,7 +1,10 @@
 module github.com/kumait/vee
 
-go 1.20
+go 1.21
 
 require (
-	golang.org/x/exp v0.0.0-20240119083558-1b970713d09a // indirect
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/exp v0.0.0-20240119083558-1b970713d09a
+	golang.org/x/net v0.20.0
+	golang.org/x/text v0.14.0
 )
@@ -0,0 +1,84 @@
+package vee
+
+type (
+	GroupConstraint[T any] struct {
+		Value      T
+		Groups     map[string]bool
+		Constraint CheckableValue[T]
+	}
+
+	SchemaGroupConstraint struct {
+		Groups      map[string]bool
+		Constraints []Checkable
+	}
+)
+
+// InGroups checks cons only when one of groups is active, see WithGroups. Constraints outside InGroups and Group run
+// in every group. A plain Check has no active group, so it never runs cons.
+func InGroups[T any](groups []string, cons ...CheckableValue[T]) CheckableValue[T] {
+	return &GroupConstraint[T]{
+		Groups:     valueSet(groups),
+		Constraint: defaultConstraints(cons),
+	}
+}
+
+// Group is InGroups for the fields and other checks of a Schema.
+func Group(groups []string, cons ...Checkable) Checkable {
+	return &SchemaGroupConstraint{
+		Groups:      valueSet(groups),
+		Constraints: cons,
+	}
+}
+
+// WithGroups activates groups for the call, such as "create" or "admin". Without it no group is active and only the
+// constraints outside groups run.
+func WithGroups(groups ...string) CheckOption {
+	return func(ctx *CheckContext) {
+		ctx.groups = append(ctx.groups, groups...)
+	}
+}
+
+func (c *GroupConstraint[T]) SetValue(value T) {
+	c.Value = value
+	c.Constraint.SetValue(value)
+}
+
+func (c *GroupConstraint[T]) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *GroupConstraint[T]) checkWith(ctx *CheckContext) error {
+	if !ctx.inGroups(c.Groups) {
+		ctx.skip("group is not active")
+		return nil
+	}
+
+	return ctx.check(c.Constraint)
+}
+
+func (c *SchemaGroupConstraint) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *SchemaGroupConstraint) checkWith(ctx *CheckContext) error {
+	if !ctx.inGroups(c.Groups) {
+		ctx.skip("group is not active")
+		return nil
+	}
+
+	return (&SchemaConstraint{Constraints: c.Constraints}).checkWith(ctx)
+}
+
+func (ctx *CheckContext) inGroups(groups map[string]bool) bool {
+	if ctx == nil {
+		return false
+	}
+
+	for _, g := range ctx.groups {
+		if groups[g] {
+			return true
+		}
+	}
+
+	return false
+}
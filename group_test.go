@@ -0,0 +1,86 @@
+package vee
+
+import "testing"
+
+type article struct {
+	ID     int
+	Title  string
+	Status string
+}
+
+func (a article) Schema() Checkable {
+	return Schema(
+		Field("id", a.ID,
+			InGroups([]string{"create"}, Forbidden[int]()),
+			InGroups([]string{"update"}, Positive[int]()),
+		),
+		Field("title", a.Title, NotBlank()),
+		Group([]string{"admin"},
+			Field("status", a.Status, OneOf("draft", "published")),
+		),
+	)
+}
+
+func TestGroups(t *testing.T) {
+	defer func(s CheckSemantic) { DefaultCheckSemantic = s }(DefaultCheckSemantic)
+	DefaultCheckSemantic = CheckSemanticAll
+
+	var tests = []struct {
+		name    string
+		article article
+		groups  []string
+		errors  int
+	}{
+		{"no group", article{ID: 1, Title: "a", Status: "x"}, nil, 0},
+		{"ungrouped always run", article{}, nil, 1},
+		{"create", article{Title: "a"}, []string{"create"}, 0},
+		{"id forbidden on create", article{ID: 1, Title: "a"}, []string{"create"}, 1},
+		{"id required on update", article{Title: "a"}, []string{"update"}, 1},
+		{"update", article{ID: 1, Title: "a"}, []string{"update"}, 0},
+		{"admin update", article{ID: 1, Title: "a", Status: "x"}, []string{"update", "admin"}, 1},
+		{"admin update valid", article{ID: 1, Title: "a", Status: "draft"}, []string{"update", "admin"}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckWith(test.article.Schema(), WithGroups(test.groups...))
+			if n := len(collectErrors(err)); n != test.errors {
+				t.Errorf("error(%v), should have %d errors but got %d", err, test.errors, n)
+			}
+		})
+	}
+
+	// groups reach nested values through Schematic
+	err := CheckWith(Value([]article{{ID: 1, Title: "a"}}, Each[[]article]()), WithGroups("create"))
+	if n := len(collectErrors(err)); n != 1 {
+		t.Errorf("error(%v), should have 1 error but got %d", err, n)
+	}
+
+	// and nested values that also implement Validatable, with or without a mask
+	e := editorial{Article: article{Title: "a"}}
+	for _, opts := range [][]CheckOption{
+		{WithGroups("update")},
+		{WithGroups("update"), WithMask("article.id")},
+	} {
+		if err := CheckWith(e.Schema(), opts...); len(collectErrors(err)) != 1 {
+			t.Errorf("error(%v), should fail the nested update group", err)
+		}
+	}
+
+	// a plain Check runs no grouped constraint
+	if err := e.Schema().Check(); err != nil {
+		t.Errorf("error(%v), should get nil but got error", err)
+	}
+}
+
+type editorial struct {
+	Article article
+}
+
+func (e editorial) Schema() Checkable {
+	return Schema(Field("article", e.Article))
+}
+
+func (a article) Validate() error {
+	return a.Schema().Check()
+}
@@ -0,0 +1,280 @@
+package vee
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	CodeUUID          = "uuid"
+	CodeUUIDVersion   = "uuid_version"
+	CodeULID          = "ulid"
+	CodeKSUID         = "ksuid"
+	CodeSemver        = "semver"
+	CodeSemverRange   = "semver_range"
+	CodeSlug          = "slug"
+	CodeBase64        = "base64"
+	CodeBase64URL     = "base64url"
+	CodeHex           = "hex"
+	CodeDecodedLength = "decoded_length"
+)
+
+const (
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	maxKSUID          = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+)
+
+type (
+	UUIDConstraint struct {
+		Value    string
+		Versions []int
+	}
+
+	ULIDConstraint struct {
+		Value string
+	}
+
+	KSUIDConstraint struct {
+		Value string
+	}
+
+	SlugConstraint struct {
+		Value string
+	}
+
+	EncodingConstraint struct {
+		Value   string
+		Code    string
+		Name    string
+		Decode  func(string) ([]byte, error)
+		Lengths []int
+	}
+)
+
+var (
+	invalidUUIDError  = ConstraintError(CodeUUID, "invalid UUID")
+	invalidULIDError  = ConstraintError(CodeULID, "invalid ULID")
+	invalidKSUIDError = ConstraintError(CodeKSUID, "invalid KSUID")
+	invalidSlugError  = ConstraintError(CodeSlug, "must contain lower case letters and digits separated by single hyphens")
+)
+
+// UUID checks a UUID in its canonical 8-4-4-4-12 hex form. If versions are given the UUID version must be one of
+// them. The nil UUID has version 0 and is rejected unless 0 is one of the versions.
+func UUID(versions ...int) CheckableValue[string] {
+	return &UUIDConstraint{
+		Versions: versions,
+	}
+}
+
+// ULID checks a 26 character Crockford base32 ULID, case-insensitively.
+func ULID() CheckableValue[string] {
+	return new(ULIDConstraint)
+}
+
+// KSUID checks a 27 character base62 KSUID.
+func KSUID() CheckableValue[string] {
+	return new(KSUIDConstraint)
+}
+
+// Slug checks a URL slug such as "my-first-post".
+func Slug() CheckableValue[string] {
+	return new(SlugConstraint)
+}
+
+// Base64 checks standard base64 with or without padding, if lengths are given the decoded value must have one of
+// them in bytes.
+func Base64(lengths ...int) CheckableValue[string] {
+	return &EncodingConstraint{
+		Code:    CodeBase64,
+		Name:    "base64",
+		Decode:  decodeBase64(base64.StdEncoding, base64.RawStdEncoding),
+		Lengths: lengths,
+	}
+}
+
+// Base64URL checks URL-safe base64 with or without padding, if lengths are given the decoded value must have one
+// of them in bytes.
+func Base64URL(lengths ...int) CheckableValue[string] {
+	return &EncodingConstraint{
+		Code:    CodeBase64URL,
+		Name:    "base64url",
+		Decode:  decodeBase64(base64.URLEncoding, base64.RawURLEncoding),
+		Lengths: lengths,
+	}
+}
+
+// Hex checks a hex string in either case, if lengths are given the decoded value must have one of them in bytes.
+func Hex(lengths ...int) CheckableValue[string] {
+	return &EncodingConstraint{
+		Code:    CodeHex,
+		Name:    "hex",
+		Decode:  hex.DecodeString,
+		Lengths: lengths,
+	}
+}
+
+func (c *UUIDConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *UUIDConstraint) Check() error {
+	v := c.Value
+	if len(v) != 36 || v[8] != '-' || v[13] != '-' || v[18] != '-' || v[23] != '-' {
+		return invalidUUIDError
+	}
+
+	for i := 0; i < len(v); i++ {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			continue
+		}
+		if !isHexDigit(v[i]) {
+			return invalidUUIDError
+		}
+	}
+
+	if v == "00000000-0000-0000-0000-000000000000" {
+		for _, ver := range c.Versions {
+			if ver == 0 {
+				return nil
+			}
+		}
+
+		return ConstraintError(CodeUUIDVersion, "cannot be the nil UUID")
+	}
+
+	if variant := hexValue(v[19]); variant < 8 || variant > 0xb {
+		return invalidUUIDError
+	}
+
+	version := hexValue(v[14])
+	if version == 0 {
+		return ConstraintError(CodeUUIDVersion, "invalid UUID version 0")
+	}
+
+	if len(c.Versions) > 0 {
+		for _, ver := range c.Versions {
+			if ver == version {
+				return nil
+			}
+		}
+
+		return ConstraintError(CodeUUIDVersion, fmt.Sprintf("must be a UUID of version %s", joinInts(c.Versions, " or ")))
+	}
+
+	return nil
+}
+
+func (c *ULIDConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *ULIDConstraint) Check() error {
+	if len(c.Value) != 26 || c.Value[0] > '7' {
+		return invalidULIDError
+	}
+
+	for _, r := range strings.ToUpper(c.Value) {
+		if !strings.ContainsRune(crockfordAlphabet, r) {
+			return invalidULIDError
+		}
+	}
+
+	return nil
+}
+
+func (c *KSUIDConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *KSUIDConstraint) Check() error {
+	if len(c.Value) != len(maxKSUID) || c.Value > maxKSUID {
+		return invalidKSUIDError
+	}
+
+	for i := 0; i < len(c.Value); i++ {
+		b := c.Value[i]
+		if !(b >= '0' && b <= '9' || b >= 'A' && b <= 'Z' || b >= 'a' && b <= 'z') {
+			return invalidKSUIDError
+		}
+	}
+
+	return nil
+}
+
+func (c *SlugConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *SlugConstraint) Check() error {
+	for _, part := range strings.Split(c.Value, "-") {
+		if part == "" {
+			return invalidSlugError
+		}
+
+		for i := 0; i < len(part); i++ {
+			if b := part[i]; !(b >= 'a' && b <= 'z' || b >= '0' && b <= '9') {
+				return invalidSlugError
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *EncodingConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *EncodingConstraint) Check() error {
+	b, err := c.Decode(c.Value)
+	if err != nil {
+		return ConstraintError(c.Code, fmt.Sprintf("invalid %s", c.Name))
+	}
+
+	if len(c.Lengths) == 0 {
+		return nil
+	}
+
+	for _, l := range c.Lengths {
+		if len(b) == l {
+			return nil
+		}
+	}
+
+	return ConstraintError(CodeDecodedLength, fmt.Sprintf("must decode to %s bytes", joinInts(c.Lengths, " or ")))
+}
+
+func decodeBase64(padded, raw *base64.Encoding) func(string) ([]byte, error) {
+	return func(s string) ([]byte, error) {
+		if strings.HasSuffix(s, "=") || len(s)%4 == 0 {
+			return padded.DecodeString(s)
+		}
+		return raw.DecodeString(s)
+	}
+}
+
+func isHexDigit(b byte) bool {
+	return b >= '0' && b <= '9' || b >= 'a' && b <= 'f' || b >= 'A' && b <= 'F'
+}
+
+func hexValue(b byte) int {
+	switch {
+	case b >= '0' && b <= '9':
+		return int(b - '0')
+	case b >= 'a' && b <= 'f':
+		return int(b-'a') + 10
+	default:
+		return int(b-'A') + 10
+	}
+}
+
+func joinInts(values []int, sep string) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = fmt.Sprint(v)
+	}
+
+	return strings.Join(s, sep)
+}
@@ -0,0 +1,41 @@
+package vee
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIdentifiers(t *testing.T) {
+	var tests = []testCase{
+		{"uuid", Value("f47ac10b-58cc-4372-a567-0e02b2c3d479", UUID()), "", false},
+		{"uuid upper case", Value("F47AC10B-58CC-4372-A567-0E02B2C3D479", UUID(4)), "", false},
+		{"uuid version", Value("f47ac10b-58cc-1372-a567-0e02b2c3d479", UUID(4, 7)), CodeUUIDVersion, true},
+		{"nil uuid", Value("00000000-0000-0000-0000-000000000000", UUID()), CodeUUIDVersion, true},
+		{"nil uuid allowed", Value("00000000-0000-0000-0000-000000000000", UUID(0, 4)), "", false},
+		{"uuid version 0", Value("f47ac10b-58cc-0372-a567-0e02b2c3d479", UUID(0, 4)), CodeUUIDVersion, true},
+		{"uuid variant", Value("f47ac10b-58cc-4372-c567-0e02b2c3d479", UUID()), CodeUUID, true},
+		{"uuid without hyphens", Value("f47ac10b58cc4372a5670e02b2c3d479", UUID()), CodeUUID, true},
+		{"ulid", Value("01ARZ3NDEKTSV4RRFFQ69G5FAV", ULID()), "", false},
+		{"ulid overflow", Value("81ARZ3NDEKTSV4RRFFQ69G5FAV", ULID()), CodeULID, true},
+		{"ulid invalid character", Value("01ARZ3NDEKTSV4RRFFQ69G5FAU", ULID()), CodeULID, true},
+		{"ksuid", Value("0ujtsYcgvSTl8PAuAdqWYSMnLOv", KSUID()), "", false},
+		{"ksuid overflow", Value("zzzzzzzzzzzzzzzzzzzzzzzzzzz", KSUID()), CodeKSUID, true},
+		{"slug", Value("my-first-post-2", Slug()), "", false},
+		{"slug double hyphen", Value("my--post", Slug()), CodeSlug, true},
+		{"slug upper case", Value("My-Post", Slug()), CodeSlug, true},
+		{"base64", Value("aGVsbG8=", Base64(5)), "", false},
+		{"base64 raw", Value("aGVsbG8", Base64()), "", false},
+		{"base64 length", Value("aGVsbG8=", Base64(16, 32)), CodeDecodedLength, true},
+		{"base64url", Value("-_-_", Base64URL(3)), "", false},
+		{"base64url with std alphabet", Value("+/+/", Base64URL()), CodeBase64URL, true},
+		{"hex", Value("DEADbeef", Hex(4)), "", false},
+		{"odd hex", Value("abc", Hex()), CodeHex, true},
+	}
+
+	runCases(t, tests)
+
+	err := Value("f47ac10b-58cc-0372-a567-0e02b2c3d479", UUID()).Check()
+	if err == nil || strings.Contains(err.Error(), "nil UUID") {
+		t.Errorf("error(%v), should report the version of a non-nil UUID", err)
+	}
+}
@@ -28,6 +28,16 @@ func NotIn[T comparable](values map[T]bool) CheckableValue[T] {
 	}
 }
 
+// OneOf is like In but takes the valid values as arguments.
+func OneOf[T comparable](values ...T) CheckableValue[T] {
+	return In(valueSet(values))
+}
+
+// NoneOf is like NotIn but takes the invalid values as arguments.
+func NoneOf[T comparable](values ...T) CheckableValue[T] {
+	return NotIn(valueSet(values))
+}
+
 func (c *InConstraint[T]) SetValue(value T) {
 	c.Value = value
 }
@@ -49,3 +59,12 @@ func (c *NotInConstraint[T]) Check() error {
 	}
 	return nil
 }
+
+func valueSet[T comparable](values []T) map[T]bool {
+	m := make(map[T]bool, len(values))
+	for _, v := range values {
+		m[v] = true
+	}
+
+	return m
+}
@@ -0,0 +1,186 @@
+package vee
+
+import (
+	_ "embed"
+	"mime"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	CodeCountry  = "country"
+	CodeCurrency = "currency"
+	CodeLanguage = "language"
+	CodeTimeZone = "timezone"
+	CodeMIMEType = "mime_type"
+)
+
+type (
+	CodeListConstraint struct {
+		Value   string
+		List    *CodeList
+		Code    string
+		Message string
+	}
+
+	LanguageTagConstraint struct {
+		Value string
+	}
+
+	MIMETypeConstraint struct {
+		Value string
+	}
+
+	// CodeList is a set of codes read from an embedded table on first use, each line of the table holds one entry
+	// and Column selects the whitespace separated field used as the code.
+	CodeList struct {
+		Data   string
+		Column int
+		once   sync.Once
+		codes  map[string]bool
+	}
+)
+
+var (
+	//go:embed data/iso3166.txt
+	iso3166Data string
+
+	//go:embed data/iso4217.txt
+	iso4217Data string
+
+	//go:embed data/timezones.txt
+	timeZoneData string
+
+	CountryAlpha2Codes = &CodeList{Data: iso3166Data, Column: 0}
+	CountryAlpha3Codes = &CodeList{Data: iso3166Data, Column: 1}
+	CurrencyCodes      = &CodeList{Data: iso4217Data}
+	TimeZoneNames      = &CodeList{Data: timeZoneData}
+
+	mimeTopLevelTypes = map[string]bool{
+		"application": true,
+		"audio":       true,
+		"example":     true,
+		"font":        true,
+		"haptics":     true,
+		"image":       true,
+		"message":     true,
+		"model":       true,
+		"multipart":   true,
+		"text":        true,
+		"video":       true,
+	}
+)
+
+var (
+	invalidLanguageError = ConstraintError(CodeLanguage, "invalid language tag")
+	invalidMIMETypeError = ConstraintError(CodeMIMEType, "invalid MIME type")
+)
+
+// CountryCode checks an upper case ISO 3166-1 alpha-2 country code such as "DE".
+func CountryCode() CheckableValue[string] {
+	return &CodeListConstraint{
+		List:    CountryAlpha2Codes,
+		Code:    CodeCountry,
+		Message: "invalid country code",
+	}
+}
+
+// CountryCode3 checks an upper case ISO 3166-1 alpha-3 country code such as "DEU".
+func CountryCode3() CheckableValue[string] {
+	return &CodeListConstraint{
+		List:    CountryAlpha3Codes,
+		Code:    CodeCountry,
+		Message: "invalid country code",
+	}
+}
+
+// CurrencyCode checks an upper case ISO 4217 currency code such as "EUR".
+func CurrencyCode() CheckableValue[string] {
+	return &CodeListConstraint{
+		List:    CurrencyCodes,
+		Code:    CodeCurrency,
+		Message: "invalid currency code",
+	}
+}
+
+// TimeZone checks an IANA time zone name such as "Europe/Berlin" against an embedded list, so it does not depend on
+// the time zone database of the host.
+func TimeZone() CheckableValue[string] {
+	return &CodeListConstraint{
+		List:    TimeZoneNames,
+		Code:    CodeTimeZone,
+		Message: "invalid time zone",
+	}
+}
+
+// LanguageTag checks a well-formed BCP 47 language tag with registered subtags, such as "en-US" or "zh-Hant-TW".
+func LanguageTag() CheckableValue[string] {
+	return new(LanguageTagConstraint)
+}
+
+// MIMEType checks a media type such as "text/html; charset=utf-8" with a registered top level type.
+func MIMEType() CheckableValue[string] {
+	return new(MIMETypeConstraint)
+}
+
+// Contains reports whether code is in the list.
+func (l *CodeList) Contains(code string) bool {
+	l.once.Do(func() {
+		l.codes = make(map[string]bool)
+		for _, line := range strings.Split(l.Data, "\n") {
+			if fields := strings.Fields(line); len(fields) > l.Column {
+				l.codes[fields[l.Column]] = true
+			}
+		}
+	})
+
+	return l.codes[code]
+}
+
+func (c *CodeListConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *CodeListConstraint) Check() error {
+	if !c.List.Contains(c.Value) {
+		return ConstraintError(c.Code, c.Message)
+	}
+
+	return nil
+}
+
+func (c *LanguageTagConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *LanguageTagConstraint) Check() error {
+	if c.Value == "" || strings.Contains(c.Value, "_") {
+		return invalidLanguageError
+	}
+
+	if _, err := language.Parse(c.Value); err != nil {
+		return invalidLanguageError
+	}
+
+	return nil
+}
+
+func (c *MIMETypeConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *MIMETypeConstraint) Check() error {
+	mediaType, _, err := mime.ParseMediaType(c.Value)
+	if err != nil {
+		return invalidMIMETypeError
+	}
+
+	top, sub, ok := strings.Cut(mediaType, "/")
+	if !ok || sub == "" || !mimeTopLevelTypes[top] {
+		return invalidMIMETypeError
+	}
+
+	return nil
+}
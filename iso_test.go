@@ -0,0 +1,32 @@
+package vee
+
+import "testing"
+
+func TestISOCodes(t *testing.T) {
+	var tests = []testCase{
+		{"country", Value("DE", CountryCode()), "", false},
+		{"lower case country", Value("de", CountryCode()), CodeCountry, true},
+		{"unknown country", Value("ZZ", CountryCode()), CodeCountry, true},
+		{"country alpha-3", Value("DEU", CountryCode3()), "", false},
+		{"alpha-2 as alpha-3", Value("DE", CountryCode3()), CodeCountry, true},
+		{"currency", Value("EUR", CurrencyCode()), "", false},
+		{"unknown currency", Value("EUX", CurrencyCode()), CodeCurrency, true},
+		{"language", Value("en", LanguageTag()), "", false},
+		{"language with script and region", Value("zh-Hant-TW", LanguageTag()), "", false},
+		{"language with underscore", Value("en_US", LanguageTag()), CodeLanguage, true},
+		{"unknown language", Value("xx-YY-zzzzzzzzz", LanguageTag()), CodeLanguage, true},
+		{"time zone", Value("Europe/Berlin", TimeZone()), "", false},
+		{"time zone link", Value("US/Pacific", TimeZone()), "", false},
+		{"local time zone", Value("Local", TimeZone()), CodeTimeZone, true},
+		{"unknown time zone", Value("Mars/Olympus_Mons", TimeZone()), CodeTimeZone, true},
+		{"mime type", Value("text/html; charset=utf-8", MIMEType()), "", false},
+		{"mime type without subtype", Value("text", MIMEType()), CodeMIMEType, true},
+		{"unknown top level mime type", Value("foo/bar", MIMEType()), CodeMIMEType, true},
+	}
+
+	runCases(t, tests)
+
+	if err := Field("role", "owner", OneOf("admin", "member")).Check(); err == nil {
+		t.Errorf("should get an error but got nil")
+	}
+}
@@ -0,0 +1,385 @@
+package vee
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type (
+	IPConstraint struct {
+		Value           string
+		Version         int
+		DenyPrivate     bool
+		DenyLoopback    bool
+		DenyNonUnicast  bool
+		DenyUnspecified bool
+	}
+
+	IPOption func(*IPConstraint)
+
+	CIDRConstraint struct {
+		Value string
+	}
+
+	HostnameConstraint struct {
+		Value string
+		FQDN  bool
+	}
+
+	PortConstraint struct {
+		Value string
+	}
+
+	HostPortConstraint struct {
+		Value string
+	}
+
+	MACConstraint struct {
+		Value string
+	}
+
+	URLConstraint struct {
+		Value   string
+		Schemes map[string]bool
+		Hosts   []string
+	}
+
+	URLOption func(*URLConstraint)
+)
+
+var (
+	invalidIPError       = errors.New("invalid IP address")
+	invalidIPv4Error     = errors.New("invalid IPv4 address")
+	invalidIPv6Error     = errors.New("invalid IPv6 address")
+	privateIPError       = errors.New("cannot be a private IP address")
+	loopbackIPError      = errors.New("cannot be a loopback IP address")
+	nonUnicastIPError    = errors.New("must be a global unicast IP address")
+	unspecifiedIPError   = errors.New("cannot be an unspecified IP address")
+	invalidCIDRError     = errors.New("invalid CIDR")
+	cidrHostBitsError    = errors.New("invalid CIDR, host bits must be zero")
+	invalidHostnameError = errors.New("invalid hostname")
+	invalidFQDNError     = errors.New("invalid fully qualified domain name")
+	invalidPortError     = errors.New("invalid port")
+	invalidHostPortError = errors.New("invalid host and port")
+	invalidMACError      = errors.New("invalid MAC address")
+	invalidURLError      = errors.New("invalid URL")
+)
+
+func IP(opts ...IPOption) CheckableValue[string] {
+	return newIPConstraint(0, opts)
+}
+
+func IPv4(opts ...IPOption) CheckableValue[string] {
+	return newIPConstraint(4, opts)
+}
+
+func IPv6(opts ...IPOption) CheckableValue[string] {
+	return newIPConstraint(6, opts)
+}
+
+// IPNoPrivate rejects private addresses (RFC 1918 and RFC 4193).
+func IPNoPrivate() IPOption {
+	return func(c *IPConstraint) {
+		c.DenyPrivate = true
+	}
+}
+
+func IPNoLoopback() IPOption {
+	return func(c *IPConstraint) {
+		c.DenyLoopback = true
+	}
+}
+
+// IPPublic accepts global unicast addresses only, rejecting private, loopback, link-local, multicast and
+// unspecified addresses.
+func IPPublic() IPOption {
+	return func(c *IPConstraint) {
+		c.DenyPrivate = true
+		c.DenyLoopback = true
+		c.DenyNonUnicast = true
+		c.DenyUnspecified = true
+	}
+}
+
+// CIDR checks an IPv4 or IPv6 prefix such as "10.0.0.0/8", host bits must be zero.
+func CIDR() CheckableValue[string] {
+	return new(CIDRConstraint)
+}
+
+// Hostname checks a host name as defined by RFC 1123.
+func Hostname() CheckableValue[string] {
+	return new(HostnameConstraint)
+}
+
+// FQDN checks a fully qualified domain name, it must have two labels at least and may end with a dot.
+func FQDN() CheckableValue[string] {
+	return &HostnameConstraint{
+		FQDN: true,
+	}
+}
+
+// Port checks a decimal port number between 1 and 65535.
+func Port() CheckableValue[string] {
+	return new(PortConstraint)
+}
+
+// HostPort checks a "host:port" pair, the host is a hostname or an IP address, IPv6 addresses must be bracketed.
+func HostPort() CheckableValue[string] {
+	return new(HostPortConstraint)
+}
+
+// MAC checks an EUI-48 or EUI-64 hardware address in any format accepted by net.ParseMAC.
+func MAC() CheckableValue[string] {
+	return new(MACConstraint)
+}
+
+// URL checks an absolute URL with a scheme and a host.
+func URL(opts ...URLOption) CheckableValue[string] {
+	c := new(URLConstraint)
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// URLSchemes restricts the URL scheme, schemes are compared case-insensitively.
+func URLSchemes(schemes ...string) URLOption {
+	return func(c *URLConstraint) {
+		c.Schemes = make(map[string]bool, len(schemes))
+		for _, s := range schemes {
+			c.Schemes[strings.ToLower(s)] = true
+		}
+	}
+}
+
+func URLHTTPSOnly() URLOption {
+	return URLSchemes("https")
+}
+
+// URLHosts restricts the URL host, a host starting with "*." matches any subdomain of the rest.
+func URLHosts(hosts ...string) URLOption {
+	return func(c *URLConstraint) {
+		c.Hosts = hosts
+	}
+}
+
+func newIPConstraint(version int, opts []IPOption) *IPConstraint {
+	c := &IPConstraint{
+		Version: version,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *IPConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *IPConstraint) Check() error {
+	addr, err := netip.ParseAddr(c.Value)
+	switch {
+	case c.Version == 4 && (err != nil || !addr.Is4()):
+		return invalidIPv4Error
+	case c.Version == 6 && (err != nil || !addr.Is6()):
+		return invalidIPv6Error
+	case err != nil:
+		return invalidIPError
+	}
+
+	addr = addr.Unmap()
+	switch {
+	case c.DenyPrivate && addr.IsPrivate():
+		return privateIPError
+	case c.DenyLoopback && addr.IsLoopback():
+		return loopbackIPError
+	case c.DenyUnspecified && addr.IsUnspecified():
+		return unspecifiedIPError
+	case c.DenyNonUnicast && !addr.IsGlobalUnicast():
+		return nonUnicastIPError
+	}
+
+	return nil
+}
+
+func (c *CIDRConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *CIDRConstraint) Check() error {
+	prefix, err := netip.ParsePrefix(c.Value)
+	if err != nil {
+		return invalidCIDRError
+	}
+
+	if prefix.Masked() != prefix {
+		return cidrHostBitsError
+	}
+
+	return nil
+}
+
+func (c *HostnameConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *HostnameConstraint) Check() error {
+	if c.FQDN {
+		if !isFQDN(c.Value) {
+			return invalidFQDNError
+		}
+		return nil
+	}
+
+	if !isHostname(c.Value) {
+		return invalidHostnameError
+	}
+
+	return nil
+}
+
+func (c *PortConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *PortConstraint) Check() error {
+	if !isPort(c.Value) {
+		return invalidPortError
+	}
+
+	return nil
+}
+
+func (c *HostPortConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *HostPortConstraint) Check() error {
+	host, port, err := net.SplitHostPort(c.Value)
+	if err != nil || !isPort(port) {
+		return invalidHostPortError
+	}
+
+	if _, err := netip.ParseAddr(host); err != nil && !isHostname(host) {
+		return invalidHostPortError
+	}
+
+	return nil
+}
+
+func (c *MACConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *MACConstraint) Check() error {
+	mac, err := net.ParseMAC(c.Value)
+	if err != nil || (len(mac) != 6 && len(mac) != 8) {
+		return invalidMACError
+	}
+
+	return nil
+}
+
+func (c *URLConstraint) quotesValue() {}
+
+func (c *URLConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *URLConstraint) Check() error {
+	u, err := url.Parse(c.Value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return invalidURLError
+	}
+
+	host := u.Hostname()
+	if _, err := netip.ParseAddr(host); err != nil && !isHostname(host) {
+		return invalidURLError
+	}
+
+	if port := u.Port(); port != "" && !isPort(port) {
+		return invalidURLError
+	}
+
+	if c.Schemes != nil && !c.Schemes[strings.ToLower(u.Scheme)] {
+		return fmt.Errorf("scheme %q is not allowed", u.Scheme)
+	}
+
+	if c.Hosts != nil && !matchHost(c.Hosts, host) {
+		return fmt.Errorf("host %q is not allowed", host)
+	}
+
+	return nil
+}
+
+func isHostname(s string) bool {
+	if s == "" || len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if !isHostLabel(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isFQDN(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if !isHostname(s) {
+		return false
+	}
+
+	i := strings.LastIndexByte(s, '.')
+	return i > 0 && !isDigits(s[i+1:])
+}
+
+func isHostLabel(label string) bool {
+	if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for i := 0; i < len(label); i++ {
+		b := label[i]
+		if !(b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9' || b == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isPort(s string) bool {
+	if s == "" || !isDigits(s) || s[0] == '0' {
+		return false
+	}
+
+	p, err := strconv.Atoi(s)
+	return err == nil && p <= 65535
+}
+
+func matchHost(patterns []string, host string) bool {
+	host = strings.ToLower(host)
+	for _, p := range patterns {
+		p = strings.ToLower(p)
+		if suffix, ok := strings.CutPrefix(p, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+		} else if host == p {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,39 @@
+package vee
+
+import "testing"
+
+func TestNetwork(t *testing.T) {
+	var tests = []testCase{
+		{"ip", Value("2001:db8::1", IP()), "", false},
+		{"invalid ip", Value("256.1.1.1", IP()), "", true},
+		{"ipv4", Value("192.0.2.1", IPv4()), "", false},
+		{"ipv6 as ipv4", Value("::1", IPv4()), "", true},
+		{"ipv4 as ipv6", Value("192.0.2.1", IPv6()), "", true},
+		{"private ip", Value("10.1.2.3", IP(IPNoPrivate())), "", true},
+		{"mapped private ip", Value("::ffff:192.168.1.1", IP(IPNoPrivate())), "", true},
+		{"loopback ip", Value("127.0.0.1", IPv4(IPNoLoopback())), "", true},
+		{"public ip", Value("8.8.8.8", IP(IPPublic())), "", false},
+		{"link local ip", Value("169.254.1.1", IP(IPPublic())), "", true},
+		{"cidr", Value("10.0.0.0/8", CIDR()), "", false},
+		{"cidr with host bits", Value("10.0.0.1/8", CIDR()), "", true},
+		{"hostname", Value("web-01.internal", Hostname()), "", false},
+		{"hostname with underscore", Value("web_01", Hostname()), "", true},
+		{"hostname with leading hyphen", Value("-web", Hostname()), "", true},
+		{"fqdn", Value("api.example.com.", FQDN()), "", false},
+		{"single label fqdn", Value("localhost", FQDN()), "", true},
+		{"port", Value("8080", Port()), "", false},
+		{"port out of range", Value("65536", Port()), "", true},
+		{"port zero", Value("0", Port()), "", true},
+		{"host port", Value("[::1]:443", HostPort()), "", false},
+		{"host port without port", Value("example.com", HostPort()), "", true},
+		{"mac", Value("00:1a:2b:3c:4d:5e", MAC()), "", false},
+		{"invalid mac", Value("00:1a:2b", MAC()), "", true},
+		{"url", Value("https://api.example.com:8443/v1?q=1", URL()), "", false},
+		{"relative url", Value("/v1/users", URL()), "", true},
+		{"https only", Value("http://example.com", URL(URLHTTPSOnly())), "", true},
+		{"allowed host", Value("https://cdn.example.com/a.png", URL(URLHosts("*.example.com"))), "", false},
+		{"denied host", Value("https://example.org/a.png", URL(URLHosts("example.com", "*.example.com"))), "", true},
+	}
+
+	runCases(t, tests)
+}
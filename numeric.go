@@ -0,0 +1,290 @@
+package vee
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+type (
+	Number interface {
+		constraints.Integer | constraints.Float
+	}
+
+	PositiveConstraint[T Number] struct {
+		Value T
+	}
+
+	NegativeConstraint[T Number] struct {
+		Value T
+	}
+
+	NonNegativeConstraint[T Number] struct {
+		Value T
+	}
+
+	NonZeroConstraint[T Number] struct {
+		Value T
+	}
+
+	MultipleOfConstraint[T constraints.Integer] struct {
+		Value T
+		Step  T
+	}
+
+	FloatMultipleOfConstraint[T constraints.Float] struct {
+		Value T
+		Step  T
+	}
+
+	FiniteConstraint[T constraints.Float] struct {
+		Value T
+	}
+
+	MaxDecimalPlacesConstraint[T constraints.Float] struct {
+		Value  T
+		Places int
+	}
+
+	GreaterThanConstraint[T constraints.Ordered] struct {
+		Value T
+		Bound T
+	}
+
+	LessThanConstraint[T constraints.Ordered] struct {
+		Value T
+		Bound T
+	}
+)
+
+var (
+	notPositiveError    = errors.New("must be positive")
+	notNegativeError    = errors.New("must be negative")
+	negativeError       = errors.New("cannot be negative")
+	zeroError           = errors.New("cannot be zero")
+	notFiniteError      = errors.New("must be a finite number")
+	notANumberError     = errors.New("is not a number")
+	infiniteNumberError = errors.New("cannot be infinite")
+)
+
+func Positive[T Number]() CheckableValue[T] {
+	return new(PositiveConstraint[T])
+}
+
+func Negative[T Number]() CheckableValue[T] {
+	return new(NegativeConstraint[T])
+}
+
+func NonNegative[T Number]() CheckableValue[T] {
+	return new(NonNegativeConstraint[T])
+}
+
+func NonZero[T Number]() CheckableValue[T] {
+	return new(NonZeroConstraint[T])
+}
+
+// MultipleOf checks that the value is an exact multiple of step, step must not be zero. See FloatMultipleOf for
+// floats.
+func MultipleOf[T constraints.Integer](step T) CheckableValue[T] {
+	if step == 0 {
+		panic("step cannot be zero")
+	}
+
+	return &MultipleOfConstraint[T]{
+		Step: step,
+	}
+}
+
+// FloatMultipleOf checks that the value is a multiple of step, such as 0.05 for prices. Both are compared exactly as
+// the decimals they are written as, so 0.3 is a multiple of 0.1 although it is not in binary floating point. Step
+// must be finite and not zero.
+func FloatMultipleOf[T constraints.Float](step T) CheckableValue[T] {
+	if step == 0 || math.IsNaN(float64(step)) || math.IsInf(float64(step), 0) {
+		panic("step must be finite and not zero")
+	}
+
+	return &FloatMultipleOfConstraint[T]{
+		Step: step,
+	}
+}
+
+// Finite rejects NaN and infinite values, NaN passes Range, Min and Max because comparisons with NaN are always false.
+func Finite[T constraints.Float]() CheckableValue[T] {
+	return new(FiniteConstraint[T])
+}
+
+// MaxDecimalPlaces checks the number of decimal places in the shortest representation of the value.
+func MaxDecimalPlaces[T constraints.Float](places int) CheckableValue[T] {
+	return &MaxDecimalPlacesConstraint[T]{
+		Places: places,
+	}
+}
+
+func GreaterThan[T constraints.Ordered](bound T) CheckableValue[T] {
+	return &GreaterThanConstraint[T]{
+		Bound: bound,
+	}
+}
+
+func LessThan[T constraints.Ordered](bound T) CheckableValue[T] {
+	return &LessThanConstraint[T]{
+		Bound: bound,
+	}
+}
+
+func (c *PositiveConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *PositiveConstraint[T]) Check() error {
+	if !(c.Value > 0) {
+		return notPositiveError
+	}
+
+	return nil
+}
+
+func (c *NegativeConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *NegativeConstraint[T]) Check() error {
+	if !(c.Value < 0) {
+		return notNegativeError
+	}
+
+	return nil
+}
+
+func (c *NonNegativeConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *NonNegativeConstraint[T]) Check() error {
+	if !(c.Value >= 0) {
+		return negativeError
+	}
+
+	return nil
+}
+
+func (c *NonZeroConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *NonZeroConstraint[T]) Check() error {
+	if c.Value == 0 {
+		return zeroError
+	}
+
+	return nil
+}
+
+func (c *MultipleOfConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *MultipleOfConstraint[T]) Check() error {
+	if c.Value%c.Step != 0 {
+		return fmt.Errorf("must be a multiple of %v", c.Step)
+	}
+
+	return nil
+}
+
+func (c *FloatMultipleOfConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *FloatMultipleOfConstraint[T]) Check() error {
+	f := float64(c.Value)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return notFiniteError
+	}
+
+	v, _ := new(big.Rat).SetString(shortestDecimal(c.Value))
+	step, _ := new(big.Rat).SetString(shortestDecimal(c.Step))
+	if !v.Quo(v, step).IsInt() {
+		return fmt.Errorf("must be a multiple of %v", c.Step)
+	}
+
+	return nil
+}
+
+func (c *FiniteConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *FiniteConstraint[T]) Check() error {
+	f := float64(c.Value)
+	if math.IsNaN(f) {
+		return notANumberError
+	} else if math.IsInf(f, 0) {
+		return infiniteNumberError
+	}
+
+	return nil
+}
+
+func (c *MaxDecimalPlacesConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *MaxDecimalPlacesConstraint[T]) Check() error {
+	f := float64(c.Value)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return notFiniteError
+	}
+
+	s := shortestDecimal(c.Value)
+	places := 0
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		places = len(s) - i - 1
+	}
+
+	if places > c.Places {
+		return fmt.Errorf("must have %d decimal places at most", c.Places)
+	}
+
+	return nil
+}
+
+func (c *GreaterThanConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *GreaterThanConstraint[T]) Check() error {
+	if !(c.Value > c.Bound) {
+		return fmt.Errorf("must be greater than %v", c.Bound)
+	}
+
+	return nil
+}
+
+func (c *LessThanConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *LessThanConstraint[T]) Check() error {
+	if !(c.Value < c.Bound) {
+		return fmt.Errorf("must be less than %v", c.Bound)
+	}
+
+	return nil
+}
+
+// shortestDecimal formats a finite float as the shortest decimal that parses back to it, without an exponent.
+func shortestDecimal[T constraints.Float](value T) string {
+	bitSize := 64
+	if reflect.ValueOf(value).Kind() == reflect.Float32 {
+		bitSize = 32
+	}
+
+	return strconv.FormatFloat(float64(value), 'f', -1, bitSize)
+}
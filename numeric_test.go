@@ -0,0 +1,36 @@
+package vee
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumeric(t *testing.T) {
+	var tests = []testCase{
+		{"positive", Value(1, Positive[int]()), "", false},
+		{"not positive", Value(0, Positive[int]()), "", true},
+		{"negative", Value(-0.5, Negative[float64]()), "", false},
+		{"non negative", Value(uint(0), NonNegative[uint]()), "", false},
+		{"non zero", Value(0, NonZero[int]()), "", true},
+		{"multiple of", Value(15, MultipleOf(5)), "", false},
+		{"not multiple of", Value(16, MultipleOf(5)), "", true},
+		{"float multiple of", Value(0.3, FloatMultipleOf(0.1)), "", false},
+		{"float price step", Value(19.95, FloatMultipleOf(0.05)), "", false},
+		{"float32 multiple of", Value(float32(0.7), FloatMultipleOf[float32](0.1)), "", false},
+		{"float not multiple of", Value(0.35, FloatMultipleOf(0.1)), "", true},
+		{"float multiple of NaN", Value(math.NaN(), FloatMultipleOf(0.1)), "", true},
+		{"finite", Value(1.5, Finite[float64]()), "", false},
+		{"NaN", Value(math.NaN(), Finite[float64]()), "", true},
+		{"infinite", Value(math.Inf(1), Finite[float64]()), "", true},
+		{"NaN not positive", Value(math.NaN(), Positive[float64]()), "", true},
+		{"decimal places", Value(12.25, MaxDecimalPlaces[float64](2)), "", false},
+		{"too many decimal places", Value(12.255, MaxDecimalPlaces[float64](2)), "", true},
+		{"float32 decimal places", Value(float32(0.1), MaxDecimalPlaces[float32](1)), "", false},
+		{"greater than", Value(3, GreaterThan(2)), "", false},
+		{"not greater than", Value(2, GreaterThan(2)), "", true},
+		{"less than", Value("a", LessThan("b")), "", false},
+		{"not less than", Value(2.0, LessThan(2.0)), "", true},
+	}
+
+	runCases(t, tests)
+}
@@ -0,0 +1,109 @@
+package vee
+
+import (
+	"time"
+)
+
+type (
+	// Observer is notified of checks for metrics, logging and tracing, see WithObserver and Observed. Paths are the
+	// dotted paths of fields, "" outside fields. Embed NopObserver to implement only some of the methods.
+	Observer interface {
+		CheckStarted(c Checkable)
+		CheckFinished(c Checkable, err error, elapsed time.Duration)
+		FieldChecked(path string, err error, elapsed time.Duration)
+		ConstraintFailed(path, constraint, code string, err error)
+		FuncChecked(path string, err error, elapsed time.Duration)
+	}
+
+	NopObserver struct{}
+
+	ObservedConstraint struct {
+		Observer   Observer
+		Constraint Checkable
+	}
+)
+
+// WithObserver notifies o of the events of the call.
+func WithObserver(o Observer) CheckOption {
+	return func(ctx *CheckContext) {
+		ctx.observers = append(ctx.observers, o)
+	}
+}
+
+// Observed notifies o whenever c is checked, with or without CheckWith.
+func Observed(o Observer, c Checkable) Checkable {
+	return &ObservedConstraint{
+		Observer:   o,
+		Constraint: c,
+	}
+}
+
+func (NopObserver) CheckStarted(Checkable) {}
+
+func (NopObserver) CheckFinished(Checkable, error, time.Duration) {}
+
+func (NopObserver) FieldChecked(string, error, time.Duration) {}
+
+func (NopObserver) ConstraintFailed(string, string, string, error) {}
+
+func (NopObserver) FuncChecked(string, error, time.Duration) {}
+
+func (c *ObservedConstraint) traceName() string {
+	return ""
+}
+
+func (c *ObservedConstraint) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *ObservedConstraint) checkWith(ctx *CheckContext) error {
+	oc := new(CheckContext)
+	if ctx != nil {
+		*oc = *ctx
+	}
+	oc.observers = append(oc.observers[:len(oc.observers):len(oc.observers)], c.Observer)
+
+	start := time.Now()
+	c.Observer.CheckStarted(c.Constraint)
+	err := oc.check(c.Constraint)
+	c.Observer.CheckFinished(c.Constraint, err, time.Since(start))
+
+	return err
+}
+
+func (ctx *CheckContext) observed() bool {
+	return ctx != nil && len(ctx.observers) > 0
+}
+
+func (ctx *CheckContext) checkStarted(c Checkable) {
+	for _, o := range ctx.observers {
+		o.CheckStarted(c)
+	}
+}
+
+func (ctx *CheckContext) checkFinished(c Checkable, err error, elapsed time.Duration) {
+	for _, o := range ctx.observers {
+		o.CheckFinished(c, err, elapsed)
+	}
+}
+
+func (ctx *CheckContext) fieldChecked(err error, elapsed time.Duration) {
+	err = ctx.redact(err)
+	for _, o := range ctx.observers {
+		o.FieldChecked(ctx.path, err, elapsed)
+	}
+}
+
+func (ctx *CheckContext) constraintFailed(c Checkable, err error) {
+	name, code, err := constraintName(c), ErrorCode(err), ctx.redact(err)
+	for _, o := range ctx.observers {
+		o.ConstraintFailed(ctx.path, name, code, err)
+	}
+}
+
+func (ctx *CheckContext) funcChecked(err error, elapsed time.Duration) {
+	err = ctx.redact(err)
+	for _, o := range ctx.observers {
+		o.FuncChecked(ctx.path, err, elapsed)
+	}
+}
@@ -0,0 +1,77 @@
+package vee
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type countingObserver struct {
+	NopObserver
+	started, finished int
+	fields            []string
+	errors            map[string]error
+	failures          []string
+	funcs             int
+}
+
+func (o *countingObserver) CheckStarted(Checkable) {
+	o.started++
+}
+
+func (o *countingObserver) CheckFinished(Checkable, error, time.Duration) {
+	o.finished++
+}
+
+func (o *countingObserver) FieldChecked(path string, err error, _ time.Duration) {
+	o.fields = append(o.fields, path)
+	o.errors[path] = err
+}
+
+func (o *countingObserver) ConstraintFailed(path, constraint, code string, _ error) {
+	o.failures = append(o.failures, path+" "+constraint+" "+code)
+}
+
+func (o *countingObserver) FuncChecked(string, error, time.Duration) {
+	o.funcs++
+}
+
+func TestObserver(t *testing.T) {
+	defer func(s CheckSemantic) { DefaultCheckSemantic = s }(DefaultCheckSemantic)
+	DefaultCheckSemantic = CheckSemanticAll
+
+	newSchema := func() Checkable {
+		return Schema(
+			Field("name", "", NotBlank()),
+			Field("country", "XX", CountryCode()),
+			Field("address", schemaAddress{Street: "Main St", City: "Ottawa"}),
+			Func(func() error { return nil }),
+		)
+	}
+
+	o := &countingObserver{errors: map[string]error{}}
+	err := CheckWith(newSchema(), WithObserver(o), WithSemantic(CheckSemanticAll))
+	if err == nil {
+		t.Fatal("should get an error but got nil")
+	}
+
+	if o.started != 1 || o.finished != 1 || o.funcs != 2 {
+		t.Errorf("should observe 1 check and 2 funcs but got %+v", o)
+	}
+
+	fields := "name,country,address.street,address.city,address"
+	if strings.Join(o.fields, ",") != fields || o.errors["name"] == nil || o.errors["address.city"] != nil {
+		t.Errorf("should observe each field once in order %s but got %v", fields, o.fields)
+	}
+
+	if strings.Join(o.failures, ", ") != "name NotBlank , country CodeList country" {
+		t.Errorf("should observe the failed constraints but got %v", o.failures)
+	}
+
+	// an observer registered on a schema is notified by a plain Check
+	o = &countingObserver{errors: map[string]error{}}
+	_ = Observed(o, newSchema()).Check()
+	if o.started != 1 || strings.Join(o.fields, ",") != fields {
+		t.Errorf("should observe the schema but got %+v", o)
+	}
+}
@@ -0,0 +1,96 @@
+package vee
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+const CodeParse = "parse"
+
+type ParseConstraint[T any] struct {
+	Value      string
+	Parse      func(string) (T, error)
+	Message    string
+	Constraint CheckableValue[T]
+}
+
+// Parse checks that the value parses with parse and then checks the parsed value against cons, message is used as
+// the error when parsing fails, see ParseError.
+func Parse[T any](parse func(string) (T, error), message string, cons ...CheckableValue[T]) CheckableValue[string] {
+	return &ParseConstraint[T]{
+		Parse:      parse,
+		Message:    message,
+		Constraint: defaultConstraints(cons),
+	}
+}
+
+// ParseInt checks a base 10 integer such as "-42" and then checks it against cons.
+func ParseInt(cons ...CheckableValue[int]) CheckableValue[string] {
+	return Parse(strconv.Atoi, "must be an integer", cons...)
+}
+
+// ParseFloat checks a finite decimal number such as "3.14" or "1e3" and then checks it against cons.
+func ParseFloat(cons ...CheckableValue[float64]) CheckableValue[string] {
+	return Parse(parseFiniteFloat, "must be a number", cons...)
+}
+
+// ParseBool checks a value accepted by strconv.ParseBool, such as "true", "false", "1" or "0".
+func ParseBool(cons ...CheckableValue[bool]) CheckableValue[string] {
+	return Parse(strconv.ParseBool, "must be true or false", cons...)
+}
+
+// ParseDuration checks a value accepted by time.ParseDuration, such as "1h30m".
+func ParseDuration(cons ...CheckableValue[time.Duration]) CheckableValue[string] {
+	return Parse(time.ParseDuration, "must be a duration such as 1h30m", cons...)
+}
+
+// ParseTime checks a time in layout, see time.Parse, and then checks it against cons.
+func ParseTime(layout string, cons ...CheckableValue[time.Time]) CheckableValue[string] {
+	parse := func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	}
+
+	return Parse(parse, fmt.Sprintf("must be a time in the format %s", layout), cons...)
+}
+
+func (c *ParseConstraint[T]) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *ParseConstraint[T]) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *ParseConstraint[T]) checkWith(ctx *CheckContext) error {
+	v, err := c.Parse(c.Value)
+	if err != nil {
+		return ctx.own(c, ParseError(err, c.Message))
+	}
+
+	c.Constraint.SetValue(v)
+	return ctx.check(c.Constraint)
+}
+
+// ParseError returns the error with code CodeParse for err, returned by a parse function. Values out of the range of
+// the type, strconv.ErrRange, are reported as such and other errors are reported with message.
+func ParseError(err error, message string) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return ConstraintError(CodeParse, "is out of range")
+	}
+
+	return ConstraintError(CodeParse, message)
+}
+
+func parseFiniteFloat(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	} else if math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, fmt.Errorf("%q is not finite", s)
+	}
+
+	return f, nil
+}
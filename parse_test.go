@@ -0,0 +1,37 @@
+package vee
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	var tests = []testCase{
+		{"int", Value("42", ParseInt(Range(1, 100))), "", false},
+		{"int out of range", Value("420", ParseInt(Range(1, 100))), "", true},
+		{"not an int", Value("4.2", ParseInt()), CodeParse, true},
+		{"int overflow", Value("99999999999999999999", ParseInt()), CodeParse, true},
+		{"empty int", Value("", ParseInt()), CodeParse, true},
+		{"float", Value("0.5", ParseFloat(Range(0.0, 1.0))), "", false},
+		{"float exponent", Value("1e3", ParseFloat(Max(1000.0))), "", false},
+		{"float nan", Value("NaN", ParseFloat()), CodeParse, true},
+		{"float overflow", Value("1e400", ParseFloat()), CodeParse, true},
+		{"bool", Value("true", ParseBool()), "", false},
+		{"not a bool", Value("yes", ParseBool()), CodeParse, true},
+		{"duration", Value("1h30m", ParseDuration(Range(time.Minute, 2*time.Hour))), "", false},
+		{"duration too long", Value("3h", ParseDuration(Range(time.Minute, 2*time.Hour))), "", true},
+		{"not a duration", Value("90", ParseDuration()), CodeParse, true},
+		{"time", Value("2024-02-29", ParseTime(time.DateOnly)), "", false},
+		{"invalid date", Value("2023-02-29", ParseTime(time.DateOnly)), CodeParse, true},
+	}
+
+	runCases(t, tests)
+
+	if err := Value("99999999999999999999", ParseInt()).Check(); err == nil || err.Error() != "is out of range" {
+		t.Errorf("error(%v), should report an out of range integer", err)
+	}
+
+	if err := Value("4.2", ParseInt()).Check(); err == nil || err.Error() != "must be an integer" {
+		t.Errorf("error(%v), should report an invalid integer", err)
+	}
+}
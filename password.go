@@ -0,0 +1,375 @@
+package vee
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	CodePasswordLength    = "password_length"
+	CodePasswordClasses   = "password_classes"
+	CodePasswordRepeated  = "password_repeated"
+	CodePasswordSequence  = "password_sequence"
+	CodePasswordKeyboard  = "password_keyboard"
+	CodePasswordEntropy   = "password_entropy"
+	CodePasswordUserInput = "password_user_input"
+	CodePasswordBreached  = "password_breached"
+)
+
+// minResemblanceLength is the shortest password or user input compared for resemblance, shorter ones match too
+// easily to mean anything.
+const minResemblanceLength = 3
+
+var keyboardRows = []string{
+	"`1234567890-=",
+	"qwertyuiop[]\\",
+	"asdfghjkl;'",
+	"zxcvbnm,./",
+}
+
+type (
+	// PasswordPolicy holds the rules checked by Password, a zero field disables its rule.
+	PasswordPolicy struct {
+		MinLength int
+		MaxLength int
+
+		// MinClasses is the number of character classes, out of lower case, upper case, digits and symbols, that
+		// the password must use.
+		MinClasses int
+
+		// MaxRepeated is the longest allowed run of the same character, such as "aaa".
+		MaxRepeated int
+
+		// MaxSequence is the longest allowed run of consecutive characters, such as "abcd" or "4321".
+		MaxSequence int
+
+		// MaxKeyboardWalk is the longest allowed run of adjacent keys in a keyboard row, such as "qwer" or "lkjh".
+		MaxKeyboardWalk int
+
+		// MinEntropy is the minimum estimated strength in bits, see PasswordEntropy.
+		MinEntropy float64
+
+		// Breached is used to reject passwords known from data breaches.
+		Breached BreachedPasswords
+	}
+
+	PasswordConstraint struct {
+		Value      string
+		Policy     PasswordPolicy
+		UserInputs []string
+	}
+
+	// BreachedPasswords looks up passwords known from data breaches.
+	BreachedPasswords interface {
+		Breached(password string) (bool, error)
+	}
+
+	// BreachedPasswordList is a BreachedPasswords held in memory as SHA-1 hashes.
+	BreachedPasswordList struct {
+		hashes map[[sha1.Size]byte]struct{}
+	}
+)
+
+// Password checks the value against policy and reports every failing rule at once as an ErrList. userInputs are
+// values the password must not contain or resemble, such as the username or the email address.
+func Password(policy PasswordPolicy, userInputs ...string) CheckableValue[string] {
+	return &PasswordConstraint{
+		Policy:     policy,
+		UserInputs: userInputs,
+	}
+}
+
+// ReadBreachedPasswords reads one password per line, either in plain text or as a hex SHA-1 hash optionally followed
+// by ":count", which is the format of the Have I Been Pwned password lists.
+func ReadBreachedPasswords(r io.Reader) (*BreachedPasswordList, error) {
+	l := &BreachedPasswordList{
+		hashes: make(map[[sha1.Size]byte]struct{}),
+	}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimRight(s.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		var h [sha1.Size]byte
+		hash, _, _ := strings.Cut(line, ":")
+		if len(hash) == 2*sha1.Size && isHexString(hash) {
+			hex.Decode(h[:], []byte(hash))
+		} else {
+			h = sha1.Sum([]byte(line))
+		}
+		l.hashes[h] = struct{}{}
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// LoadBreachedPasswords reads a file in the format accepted by ReadBreachedPasswords.
+func LoadBreachedPasswords(path string) (*BreachedPasswordList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadBreachedPasswords(f)
+}
+
+func (l *BreachedPasswordList) Breached(password string) (bool, error) {
+	_, ok := l.hashes[sha1.Sum([]byte(password))]
+	return ok, nil
+}
+
+func (c *PasswordConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *PasswordConstraint) Check() error {
+	p := c.Policy
+	pw := c.Value
+	var e ErrList
+
+	if l := utf8.RuneCountInString(pw); p.MinLength > 0 && l < p.MinLength {
+		e = append(e, ConstraintError(CodePasswordLength, fmt.Sprintf("must have %d characters at least", p.MinLength)))
+	} else if p.MaxLength > 0 && l > p.MaxLength {
+		e = append(e, ConstraintError(CodePasswordLength, fmt.Sprintf("must have %d characters at most", p.MaxLength)))
+	}
+
+	if p.MinClasses > 0 && passwordClasses(pw) < p.MinClasses {
+		e = append(e, ConstraintError(CodePasswordClasses, fmt.Sprintf(
+			"must use %d of lower case, upper case, digits and symbols at least", p.MinClasses)))
+	}
+
+	if p.MaxRepeated > 0 && longestRun(pw, func(a, b rune) bool { return a == b }) > p.MaxRepeated {
+		e = append(e, ConstraintError(CodePasswordRepeated, fmt.Sprintf(
+			"cannot repeat a character more than %d times in a row", p.MaxRepeated)))
+	}
+
+	if p.MaxSequence > 0 && longestSequence(pw) > p.MaxSequence {
+		e = append(e, ConstraintError(CodePasswordSequence, fmt.Sprintf(
+			"cannot contain sequences such as abcd or 1234 longer than %d characters", p.MaxSequence)))
+	}
+
+	if p.MaxKeyboardWalk > 0 && longestRun(strings.ToLower(pw), keyboardAdjacent) > p.MaxKeyboardWalk {
+		e = append(e, ConstraintError(CodePasswordKeyboard, fmt.Sprintf(
+			"cannot contain keyboard patterns such as qwer longer than %d characters", p.MaxKeyboardWalk)))
+	}
+
+	if p.MinEntropy > 0 && PasswordEntropy(pw) < p.MinEntropy {
+		e = append(e, ConstraintError(CodePasswordEntropy, "is too easy to guess"))
+	}
+
+	if c.resemblesUserInput() {
+		e = append(e, ConstraintError(CodePasswordUserInput, "cannot contain or resemble the username or email"))
+	}
+
+	if p.Breached != nil {
+		breached, err := p.Breached.Breached(pw)
+		if err != nil {
+			e = append(e, fmt.Errorf("cannot check breached passwords: %w", err))
+		} else if breached {
+			e = append(e, ConstraintError(CodePasswordBreached, "has appeared in a data breach"))
+		}
+	}
+
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+// PasswordEntropy estimates the strength of password in bits. Each character adds log2 of the size of the character
+// classes used, except characters that continue a repeat, a sequence or a keyboard walk, which add one bit.
+func PasswordEntropy(password string) float64 {
+	pool := 0
+	for class, size := range []int{26, 26, 10, 33} {
+		if passwordUsesClass(password, class) {
+			pool += size
+		}
+	}
+
+	if pool == 0 {
+		return 0
+	}
+
+	bits := 0.0
+	perChar := math.Log2(float64(pool))
+	var prev rune = -1
+	for _, r := range strings.ToLower(password) {
+		if prev >= 0 && (r == prev || r-prev == 1 || prev-r == 1 || keyboardAdjacent(prev, r)) {
+			bits++
+		} else {
+			bits += perChar
+		}
+		prev = r
+	}
+
+	return bits
+}
+
+func (c *PasswordConstraint) resemblesUserInput() bool {
+	pw := strings.ToLower(c.Value)
+	if utf8.RuneCountInString(pw) < minResemblanceLength {
+		return false
+	}
+
+	for _, input := range c.UserInputs {
+		input = strings.ToLower(input)
+		if local, _, ok := strings.Cut(input, "@"); ok {
+			input = local
+		}
+
+		if utf8.RuneCountInString(input) < minResemblanceLength {
+			continue
+		}
+
+		if strings.Contains(pw, input) || strings.Contains(input, pw) {
+			return true
+		}
+
+		if d := levenshtein(pw, input); d <= utf8.RuneCountInString(input)/3 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func passwordClasses(pw string) int {
+	n := 0
+	for class := 0; class < 4; class++ {
+		if passwordUsesClass(pw, class) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// passwordUsesClass reports whether pw has a character of class, 0 lower case, 1 upper case, 2 digits, 3 symbols.
+func passwordUsesClass(pw string, class int) bool {
+	for _, r := range pw {
+		switch {
+		case unicode.IsLower(r):
+			if class == 0 {
+				return true
+			}
+		case unicode.IsUpper(r):
+			if class == 1 {
+				return true
+			}
+		case unicode.IsDigit(r):
+			if class == 2 {
+				return true
+			}
+		default:
+			if class == 3 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// longestRun returns the length of the longest run of runes where each rune follows the previous per next.
+func longestRun(s string, next func(prev, r rune) bool) int {
+	longest, run := 0, 0
+	var prev rune = -1
+	for _, r := range s {
+		if prev >= 0 && next(prev, r) {
+			run++
+		} else {
+			run = 1
+		}
+
+		if run > longest {
+			longest = run
+		}
+		prev = r
+	}
+
+	return longest
+}
+
+func longestSequence(s string) int {
+	s = strings.ToLower(s)
+	up := longestRun(s, func(prev, r rune) bool { return r-prev == 1 })
+	down := longestRun(s, func(prev, r rune) bool { return prev-r == 1 })
+	if up > down {
+		return up
+	}
+
+	return down
+}
+
+func keyboardAdjacent(a, b rune) bool {
+	for _, row := range keyboardRows {
+		i := strings.IndexRune(row, a)
+		j := strings.IndexRune(row, b)
+		if i >= 0 && j >= 0 && (i-j == 1 || j-i == 1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+
+	return m
+}
+
+func isHexString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isHexDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,65 @@
+package vee
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	breached, err := ReadBreachedPasswords(strings.NewReader("Password1!\n" +
+		"7C4A8D09CA3762AF61E59520943DC26494F8941B:24230577\n")) // 123456
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := PasswordPolicy{
+		MinLength:       10,
+		MaxLength:       64,
+		MinClasses:      3,
+		MaxRepeated:     2,
+		MaxSequence:     3,
+		MaxKeyboardWalk: 3,
+		MinEntropy:      40,
+		Breached:        breached,
+	}
+
+	var tests = []struct {
+		name  string
+		input string
+		codes []string
+	}{
+		{"strong", "correct-Horse7battery", nil},
+		{"short and weak", "aaab", []string{CodePasswordLength, CodePasswordClasses, CodePasswordRepeated, CodePasswordEntropy}},
+		{"sequence", "Xabcde!9Zq%t", []string{CodePasswordSequence}},
+		{"keyboard walk", "Qwerty#8zLm2", []string{CodePasswordKeyboard}},
+		{"user input", "7Ada.Lovelace!", []string{CodePasswordUserInput}},
+		{"similar to user input", "Adalovelac3!", []string{CodePasswordUserInput}},
+		{"empty", "", []string{CodePasswordLength, CodePasswordClasses, CodePasswordEntropy}},
+		{"too short to resemble user input", "ad", []string{CodePasswordLength, CodePasswordClasses, CodePasswordEntropy}},
+		{"breached", "Password1!", []string{CodePasswordBreached}},
+		{"breached hash", "123456", []string{CodePasswordLength, CodePasswordClasses, CodePasswordSequence,
+			CodePasswordKeyboard, CodePasswordEntropy, CodePasswordBreached}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := Password(policy, "ada.lovelace@example.com")
+			c.SetValue(test.input)
+			err := c.Check()
+
+			var codes []string
+			var el ErrList
+			if errors.As(err, &el) {
+				for _, e := range el {
+					codes = append(codes, ErrorCode(e))
+				}
+			}
+
+			if fmt.Sprint(codes) != fmt.Sprint(test.codes) {
+				t.Errorf("error(%v), should get codes %v but got %v", err, test.codes, codes)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package vee
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	CodePathTraversal = "path_traversal"
+	CodePathAbsolute  = "path_absolute"
+	CodePathRelative  = "path_relative"
+	CodePathNotFound  = "path_not_found"
+	CodePathType      = "path_type"
+	CodePathReadable  = "path_readable"
+	CodeExtension     = "extension"
+	CodeWithinRoot    = "within_root"
+)
+
+const (
+	fsExists fsCheck = iota
+	fsFile
+	fsDir
+	fsReadable
+)
+
+type (
+	fsCheck int
+
+	PathCleanConstraint struct {
+		Value string
+	}
+
+	PathKindConstraint struct {
+		Value    string
+		Absolute bool
+	}
+
+	FSConstraint struct {
+		Value string
+		FS    fs.FS
+		check fsCheck
+	}
+
+	ExtensionConstraint struct {
+		Value      string
+		Extensions []string
+	}
+
+	WithinRootConstraint struct {
+		Value string
+		Root  string
+	}
+)
+
+// PathClean rejects paths with a ".." element, with either slash as separator, and paths with NUL bytes.
+func PathClean() CheckableValue[string] {
+	return new(PathCleanConstraint)
+}
+
+func RelativePath() CheckableValue[string] {
+	return &PathKindConstraint{}
+}
+
+func AbsolutePath() CheckableValue[string] {
+	return &PathKindConstraint{Absolute: true}
+}
+
+// FileExists checks that the value names a regular file in fsys. Names are resolved as by fs.Stat, so they are slash
+// separated and unrooted, use os.DirFS to check paths on disk relative to a directory and fstest.MapFS in tests.
+func FileExists(fsys fs.FS) CheckableValue[string] {
+	return &FSConstraint{FS: fsys, check: fsFile}
+}
+
+// DirExists checks that the value names a directory in fsys, see FileExists.
+func DirExists(fsys fs.FS) CheckableValue[string] {
+	return &FSConstraint{FS: fsys, check: fsDir}
+}
+
+// Readable checks that the value names a file or directory in fsys that can be opened, see FileExists.
+func Readable(fsys fs.FS) CheckableValue[string] {
+	return &FSConstraint{FS: fsys, check: fsReadable}
+}
+
+// Extension checks that the file extension is one of exts, compared case insensitively, exts may be given with or
+// without the leading dot.
+func Extension(exts ...string) CheckableValue[string] {
+	c := &ExtensionConstraint{}
+	for _, ext := range exts {
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		c.Extensions = append(c.Extensions, strings.ToLower(ext))
+	}
+
+	return c
+}
+
+// WithinRoot checks that joining root and the value, or the value itself if it is absolute, stays inside root. The
+// check is lexical, symbolic links are not resolved.
+func WithinRoot(root string) CheckableValue[string] {
+	return &WithinRootConstraint{
+		Root: filepath.Clean(root),
+	}
+}
+
+func (c *PathCleanConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *PathCleanConstraint) Check() error {
+	if strings.IndexByte(c.Value, 0) >= 0 {
+		return ConstraintError(CodePathTraversal, "cannot contain NUL bytes")
+	}
+
+	for _, elem := range strings.FieldsFunc(c.Value, isPathSeparator) {
+		if elem == ".." {
+			return ConstraintError(CodePathTraversal, "cannot contain ..")
+		}
+	}
+
+	return nil
+}
+
+func (c *PathKindConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *PathKindConstraint) Check() error {
+	abs := filepath.IsAbs(c.Value) || strings.HasPrefix(c.Value, "/") || strings.HasPrefix(c.Value, `\`)
+	if c.Absolute && !abs {
+		return ConstraintError(CodePathAbsolute, "must be an absolute path")
+	} else if !c.Absolute && (abs || filepath.VolumeName(c.Value) != "") {
+		return ConstraintError(CodePathRelative, "must be a relative path")
+	}
+
+	return nil
+}
+
+func (c *FSConstraint) quotesValue() {}
+
+func (c *FSConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *FSConstraint) Check() error {
+	if !fs.ValidPath(c.Value) {
+		return ConstraintError(CodePathNotFound, "invalid path")
+	}
+
+	if c.check == fsReadable {
+		f, err := c.FS.Open(c.Value)
+		if err != nil {
+			return fsError(err)
+		}
+		f.Close()
+		return nil
+	}
+
+	info, err := fs.Stat(c.FS, c.Value)
+	if err != nil {
+		return fsError(err)
+	}
+
+	if c.check == fsFile && !info.Mode().IsRegular() {
+		return ConstraintError(CodePathType, "must be a file")
+	} else if c.check == fsDir && !info.IsDir() {
+		return ConstraintError(CodePathType, "must be a directory")
+	}
+
+	return nil
+}
+
+func (c *ExtensionConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *ExtensionConstraint) Check() error {
+	ext := strings.ToLower(filepath.Ext(c.Value))
+	for _, e := range c.Extensions {
+		if ext == e {
+			return nil
+		}
+	}
+
+	return ConstraintError(CodeExtension, fmt.Sprintf("must have extension %s", strings.Join(c.Extensions, ", ")))
+}
+
+func (c *WithinRootConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *WithinRootConstraint) Check() error {
+	p := c.Value
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(c.Root, p)
+	}
+
+	rel, err := filepath.Rel(c.Root, filepath.Clean(p))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ConstraintError(CodeWithinRoot, fmt.Sprintf("must be inside %s", c.Root))
+	}
+
+	return nil
+}
+
+func fsError(err error) error {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return ConstraintError(CodePathNotFound, "does not exist")
+	case errors.Is(err, fs.ErrPermission):
+		return ConstraintError(CodePathReadable, "is not readable")
+	default:
+		return ConstraintError(CodePathReadable, fmt.Sprintf("cannot be read: %v", err))
+	}
+}
+
+func isPathSeparator(r rune) bool {
+	return r == '/' || r == '\\'
+}
@@ -0,0 +1,43 @@
+package vee
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func TestPaths(t *testing.T) {
+	fsys := fstest.MapFS{
+		"config/app.yaml": {Data: []byte("name: app")},
+		"data":            {Mode: fs.ModeDir},
+	}
+
+	var tests = []testCase{
+		{"clean", Value("a/b/c.txt", PathClean()), "", false},
+		{"dots in name", Value("a/..b/c", PathClean()), "", false},
+		{"traversal", Value("a/../../etc/passwd", PathClean()), CodePathTraversal, true},
+		{"windows traversal", Value(`a\..\b`, PathClean()), CodePathTraversal, true},
+		{"relative", Value("a/b", RelativePath()), "", false},
+		{"not relative", Value("/a/b", RelativePath()), CodePathRelative, true},
+		{"absolute", Value("/a/b", AbsolutePath()), "", false},
+		{"not absolute", Value("a/b", AbsolutePath()), CodePathAbsolute, true},
+		{"file exists", Value("config/app.yaml", FileExists(fsys)), "", false},
+		{"file missing", Value("config/db.yaml", FileExists(fsys)), CodePathNotFound, true},
+		{"file is a dir", Value("config", FileExists(fsys)), CodePathType, true},
+		{"dir exists", Value("data", DirExists(fsys)), "", false},
+		{"implicit dir exists", Value("config", DirExists(fsys)), "", false},
+		{"dir is a file", Value("config/app.yaml", DirExists(fsys)), CodePathType, true},
+		{"readable", Value("config/app.yaml", Readable(fsys)), "", false},
+		{"unrooted only", Value("/config/app.yaml", Readable(fsys)), CodePathNotFound, true},
+		{"extension", Value("photo.JPG", Extension("jpg", ".png")), "", false},
+		{"wrong extension", Value("photo.gif", Extension("jpg", ".png")), CodeExtension, true},
+		{"no extension", Value("photo", Extension("jpg")), CodeExtension, true},
+		{"within root", Value("uploads/a.txt", WithinRoot("/srv/data")), "", false},
+		{"absolute within root", Value("/srv/data/a.txt", WithinRoot("/srv/data/")), "", false},
+		{"escapes root", Value("../secret", WithinRoot("/srv/data")), CodeWithinRoot, true},
+		{"sibling of root", Value("/srv/database", WithinRoot("/srv/data")), CodeWithinRoot, true},
+		{"dotdot name in root", Value("..a", WithinRoot("/srv/data")), "", false},
+	}
+
+	runCases(t, tests)
+}
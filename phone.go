@@ -0,0 +1,158 @@
+package vee
+
+import (
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	CodePhone        = "phone"
+	CodePhoneCountry = "phone_country"
+	CodePhoneLength  = "phone_length"
+	CodePhoneRegion  = "phone_region"
+)
+
+const e164MaxDigits = 15
+
+type (
+	PhoneConstraint struct {
+		Value      string
+		Regions    map[string]bool
+		Normalized *string
+	}
+
+	PhoneOption func(*PhoneConstraint)
+
+	phoneRule struct {
+		region string
+		minLen int
+		maxLen int
+	}
+)
+
+var (
+	//go:embed data/phone.txt
+	phoneData string
+
+	phoneRulesOnce sync.Once
+	phoneRules     map[string][]phoneRule
+
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+)
+
+var (
+	invalidPhoneError = ConstraintError(CodePhone, "invalid phone number, must be in international format such as +14155550123")
+	phoneCountryError = ConstraintError(CodePhoneCountry, "invalid phone number, unknown country calling code")
+	phoneLengthError  = ConstraintError(CodePhoneLength, "invalid phone number, wrong length for country")
+	phoneRegionError  = ConstraintError(CodePhoneRegion, "phone number region is not allowed")
+)
+
+// Phone checks a phone number in E.164 format, spaces, hyphens, dots and parentheses are ignored. The country
+// calling code must be known and the national number length must match one of its regions according to the
+// embedded metadata.
+func Phone(opts ...PhoneOption) CheckableValue[string] {
+	c := new(PhoneConstraint)
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// PhoneRegions accepts numbers of the given ISO 3166-1 alpha-2 regions only. Regions sharing a calling code, such as
+// US and CA, are not told apart, a number matching the calling code and length of any of them is accepted.
+func PhoneRegions(regions ...string) PhoneOption {
+	return func(c *PhoneConstraint) {
+		c.Regions = valueSet(regions)
+	}
+}
+
+// PhoneNormalizeTo stores the number in normalized E.164 form, such as "+14155550123", in dst after a successful
+// check, dst is set to "" if the check fails.
+func PhoneNormalizeTo(dst *string) PhoneOption {
+	return func(c *PhoneConstraint) {
+		c.Normalized = dst
+	}
+}
+
+func (c *PhoneConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *PhoneConstraint) Check() error {
+	number, err := c.normalize()
+	if c.Normalized != nil {
+		*c.Normalized = number
+	}
+
+	return err
+}
+
+func (c *PhoneConstraint) normalize() (string, error) {
+	number := phoneSeparators.Replace(strings.TrimSpace(c.Value))
+	digits, ok := strings.CutPrefix(number, "+")
+	if !ok || digits == "" || len(digits) > e164MaxDigits || !isDigits(digits) || digits[0] == '0' {
+		return "", invalidPhoneError
+	}
+
+	rules := loadPhoneRules()
+	var matched []phoneRule
+	for i := 1; i <= 3 && i < len(digits); i++ {
+		if r, ok := rules[digits[:i]]; ok {
+			matched = r
+			digits = digits[i:]
+			break
+		}
+	}
+
+	if matched == nil {
+		return "", phoneCountryError
+	}
+
+	regionAllowed := c.Regions == nil
+	for _, r := range matched {
+		if c.Regions != nil && !c.Regions[r.region] {
+			continue
+		}
+
+		regionAllowed = true
+		if len(digits) >= r.minLen && len(digits) <= r.maxLen {
+			return number, nil
+		}
+	}
+
+	if !regionAllowed {
+		return "", phoneRegionError
+	}
+
+	return "", phoneLengthError
+}
+
+func loadPhoneRules() map[string][]phoneRule {
+	phoneRulesOnce.Do(func() {
+		phoneRules = make(map[string][]phoneRule)
+		for _, line := range strings.Split(phoneData, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) != 4 || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			minLen, err1 := strconv.Atoi(fields[2])
+			maxLen, err2 := strconv.Atoi(fields[3])
+			if err1 != nil || err2 != nil {
+				panic(fmt.Sprintf("invalid phone metadata line %q", line))
+			}
+
+			phoneRules[fields[1]] = append(phoneRules[fields[1]], phoneRule{
+				region: fields[0],
+				minLen: minLen,
+				maxLen: maxLen,
+			})
+		}
+	})
+
+	return phoneRules
+}
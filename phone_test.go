@@ -0,0 +1,31 @@
+package vee
+
+import "testing"
+
+func TestPhone(t *testing.T) {
+	var tests = []testCase{
+		{"us", Value("+1 (415) 555-0123", Phone()), "", false},
+		{"de", Value("+49 30 1234567", Phone()), "", false},
+		{"gb", Value("+44 20 7946 0958", Phone(PhoneRegions("GB"))), "", false},
+		{"national format", Value("(415) 555-0123", Phone()), CodePhone, true},
+		{"too many digits", Value("+1234567890123456", Phone()), CodePhone, true},
+		{"letters", Value("+1 415 CALL NOW", Phone()), CodePhone, true},
+		{"unknown calling code", Value("+8091234567", Phone()), CodePhoneCountry, true},
+		{"too short for country", Value("+1 415 555 012", Phone()), CodePhoneLength, true},
+		{"region not allowed", Value("+33 1 23 45 67 89", Phone(PhoneRegions("DE", "AT"))), CodePhoneRegion, true},
+		{"shared calling code", Value("+1 604 555 0123", Phone(PhoneRegions("CA"))), "", false},
+	}
+
+	runCases(t, tests)
+
+	var normalized string
+	err := Field("phone", " +44 (0)20 7946-0958", Phone(PhoneNormalizeTo(&normalized))).Check()
+	if err == nil {
+		t.Errorf("trunk prefix should be rejected")
+	}
+
+	err = Field("phone", " +44 20 7946-0958", Phone(PhoneNormalizeTo(&normalized))).Check()
+	if err != nil || normalized != "+442079460958" {
+		t.Errorf("error(%v), should normalize to +442079460958 but got %q", err, normalized)
+	}
+}
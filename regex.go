@@ -6,6 +6,7 @@ import (
 	"regexp"
 )
 
+// EmailRegex is kept for compatibility, use Email for validating email addresses.
 var EmailRegex = regexp.MustCompile(`^\w+([.-]?\w+)*@\w+([.-]?\w+)*(\.\w{2,3})+$`)
 
 type RegexConstraint struct {
@@ -14,13 +15,6 @@ type RegexConstraint struct {
 	Regex *regexp.Regexp
 }
 
-func Email() CheckableValue[string] {
-	return &RegexConstraint{
-		Error: "invalid email",
-		Regex: EmailRegex,
-	}
-}
-
 func (c *RegexConstraint) SetValue(value string) {
 	c.Value = value
 	return
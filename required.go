@@ -24,3 +24,24 @@ func (c *RequiredConstraint[T]) SetValue(value T) {
 	c.Value = value
 	return
 }
+
+type ForbiddenConstraint[T comparable] struct {
+	Value T
+}
+
+// Forbidden checks that the value is the zero value of T, such as an id that clients must not send on create.
+func Forbidden[T comparable]() CheckableValue[T] {
+	return &ForbiddenConstraint[T]{}
+}
+
+func (c *ForbiddenConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *ForbiddenConstraint[T]) Check() error {
+	var zero T
+	if c.Value != zero {
+		return errors.New("must not be set")
+	}
+	return nil
+}
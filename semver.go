@@ -0,0 +1,316 @@
+package vee
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	SemverConstraint struct {
+		Value string
+		Range string
+		Sets  [][]semverComparator
+	}
+
+	semver struct {
+		major, minor, patch uint64
+		pre                 []string
+	}
+
+	semverComparator struct {
+		op      string
+		version semver
+	}
+)
+
+var (
+	invalidSemverError = ConstraintError(CodeSemver, "invalid semantic version")
+)
+
+// Semver checks a semantic version as defined by semver 2.0.0, such as "1.4.0-rc.1+build.5".
+func Semver() CheckableValue[string] {
+	return new(SemverConstraint)
+}
+
+// SemverRange checks a semantic version that satisfies expr, a set of space separated comparators that must all
+// match, such as ">=1.2.0 <2". Sets can be combined with "||". The operators are =, >, >=, <, <=, ~ (same minor)
+// and ^ (same major), partial versions are accepted and a bare partial version such as "1.2" matches any 1.2.x.
+func SemverRange(expr string) CheckableValue[string] {
+	sets, err := parseSemverRange(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return &SemverConstraint{
+		Range: expr,
+		Sets:  sets,
+	}
+}
+
+func (c *SemverConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *SemverConstraint) Check() error {
+	v, ok := parseSemver(c.Value)
+	if !ok {
+		return invalidSemverError
+	}
+
+	if c.Sets == nil {
+		return nil
+	}
+
+	for _, set := range c.Sets {
+		if v.satisfies(set) {
+			return nil
+		}
+	}
+
+	return ConstraintError(CodeSemverRange, fmt.Sprintf("must satisfy version range %s", c.Range))
+}
+
+func parseSemver(s string) (semver, bool) {
+	s, build, hasBuild := strings.Cut(s, "+")
+	if hasBuild {
+		for _, id := range strings.Split(build, ".") {
+			if id == "" || !isSemverIdentifier(id) {
+				return semver{}, false
+			}
+		}
+	}
+
+	core, pre, hasPre := strings.Cut(s, "-")
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return semver{}, false
+	}
+
+	var v semver
+	var ok bool
+	if v.major, ok = parseSemverNumber(parts[0]); !ok {
+		return semver{}, false
+	} else if v.minor, ok = parseSemverNumber(parts[1]); !ok {
+		return semver{}, false
+	} else if v.patch, ok = parseSemverNumber(parts[2]); !ok {
+		return semver{}, false
+	}
+
+	if hasPre {
+		v.pre = strings.Split(pre, ".")
+		for _, id := range v.pre {
+			if id == "" || !isSemverIdentifier(id) || (isDigits(id) && len(id) > 1 && id[0] == '0') {
+				return semver{}, false
+			}
+		}
+	}
+
+	return v, true
+}
+
+func parseSemverNumber(s string) (uint64, bool) {
+	if s == "" || !isDigits(s) || (len(s) > 1 && s[0] == '0') {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(s, 10, 64)
+	return n, err == nil
+}
+
+func isSemverIdentifier(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if b := s[i]; !(b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parseSemverRange(expr string) ([][]semverComparator, error) {
+	var sets [][]semverComparator
+	for _, alt := range strings.Split(expr, "||") {
+		fields := strings.Fields(alt)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("invalid version range %q", expr)
+		}
+
+		var set []semverComparator
+		for _, f := range fields {
+			cmps, err := parseSemverComparator(f)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version range %q: %w", expr, err)
+			}
+			set = append(set, cmps...)
+		}
+		sets = append(sets, set)
+	}
+
+	return sets, nil
+}
+
+// parseSemverComparator expands a single comparator, partial versions and the ~ and ^ operators become a pair of
+// plain comparators.
+func parseSemverComparator(s string) ([]semverComparator, error) {
+	rest := strings.TrimLeft(s, "<>=~^")
+	op := s[:len(s)-len(rest)]
+	switch op {
+	case "", "=", ">", ">=", "<", "<=", "~", "^":
+	default:
+		return nil, fmt.Errorf("invalid operator %q", op)
+	}
+
+	v, n, err := parsePartialSemver(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	// next returns the first version after all versions matching the partial version up to the given part.
+	next := func(parts int) semver {
+		switch parts {
+		case 1:
+			return semver{major: v.major + 1}
+		case 2:
+			return semver{major: v.major, minor: v.minor + 1}
+		default:
+			return semver{major: v.major, minor: v.minor, patch: v.patch + 1}
+		}
+	}
+
+	exact := n == 3
+	switch op {
+	case "", "=":
+		if exact {
+			return []semverComparator{{"=", v}}, nil
+		}
+		return []semverComparator{{">=", v}, {"<", next(n)}}, nil
+	case ">":
+		if exact {
+			return []semverComparator{{">", v}}, nil
+		}
+		return []semverComparator{{">=", next(n)}}, nil
+	case "<=":
+		if exact {
+			return []semverComparator{{"<=", v}}, nil
+		}
+		return []semverComparator{{"<", next(n)}}, nil
+	case "~":
+		parts := n
+		if parts > 2 {
+			parts = 2
+		}
+		return []semverComparator{{">=", v}, {"<", next(parts)}}, nil
+	case "^":
+		parts := 1
+		if v.major == 0 && n > 1 {
+			parts = 2
+			if v.minor == 0 && n > 2 {
+				parts = 3
+			}
+		}
+		return []semverComparator{{">=", v}, {"<", next(parts)}}, nil
+	default:
+		return []semverComparator{{op, v}}, nil
+	}
+}
+
+func parsePartialSemver(s string) (semver, int, error) {
+	if v, ok := parseSemver(s); ok {
+		return v, 3, nil
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 2 {
+		return semver{}, 0, fmt.Errorf("invalid version %q", s)
+	}
+
+	var nums [2]uint64
+	for i, p := range parts {
+		n, ok := parseSemverNumber(p)
+		if !ok {
+			return semver{}, 0, fmt.Errorf("invalid version %q", s)
+		}
+		nums[i] = n
+	}
+
+	return semver{major: nums[0], minor: nums[1]}, len(parts), nil
+}
+
+func (v semver) satisfies(set []semverComparator) bool {
+	for _, c := range set {
+		r := v.compare(c.version)
+		var ok bool
+		switch c.op {
+		case "=":
+			ok = r == 0
+		case ">":
+			ok = r > 0
+		case ">=":
+			ok = r >= 0
+		case "<":
+			ok = r < 0
+		case "<=":
+			ok = r <= 0
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compare orders versions by semver precedence, a pre-release version has lower precedence than its release.
+func (v semver) compare(o semver) int {
+	if r := compareUint(v.major, o.major); r != 0 {
+		return r
+	} else if r := compareUint(v.minor, o.minor); r != 0 {
+		return r
+	} else if r := compareUint(v.patch, o.patch); r != 0 {
+		return r
+	}
+
+	switch {
+	case len(v.pre) == 0 && len(o.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(o.pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.pre) && i < len(o.pre); i++ {
+		a, b := v.pre[i], o.pre[i]
+		aNum, bNum := isDigits(a), isDigits(b)
+		switch {
+		case aNum && bNum:
+			if len(a) != len(b) {
+				return compareUint(uint64(len(a)), uint64(len(b)))
+			}
+			if a != b {
+				return strings.Compare(a, b)
+			}
+		case aNum:
+			return -1
+		case bNum:
+			return 1
+		case a != b:
+			return strings.Compare(a, b)
+		}
+	}
+
+	return compareUint(uint64(len(v.pre)), uint64(len(o.pre)))
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
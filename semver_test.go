@@ -0,0 +1,22 @@
+package vee
+
+import "testing"
+
+func TestSemver(t *testing.T) {
+	var tests = []testCase{
+		{"semver", Value("1.4.0-rc.1+build.5", Semver()), "", false},
+		{"semver leading zero", Value("01.4.0", Semver()), CodeSemver, true},
+		{"semver partial", Value("1.4", Semver()), CodeSemver, true},
+		{"semver empty build", Value("1.2.3+", Semver()), CodeSemver, true},
+		{"semver empty build identifier", Value("1.2.3+bad..meta", Semver()), CodeSemver, true},
+		{"semver in range", Value("1.9.3", SemverRange(">=1.2.0 <2")), "", false},
+		{"semver below range", Value("1.1.9", SemverRange(">=1.2.0 <2")), CodeSemverRange, true},
+		{"semver pre-release below range", Value("2.0.0-rc.1", SemverRange(">=2")), CodeSemverRange, true},
+		{"semver caret", Value("0.2.9", SemverRange("^0.2.3")), "", false},
+		{"semver caret major", Value("0.3.0", SemverRange("^0.2.3")), CodeSemverRange, true},
+		{"semver tilde", Value("1.3.0", SemverRange("~1.2.3")), CodeSemverRange, true},
+		{"semver alternatives", Value("3.1.0", SemverRange("1 || 3")), "", false},
+	}
+
+	runCases(t, tests)
+}
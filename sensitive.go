@@ -0,0 +1,105 @@
+package vee
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type (
+	// quotingConstraint is implemented by the constraints of this package whose messages may contain parts of the
+	// value, such as the domain of an email address.
+	quotingConstraint interface {
+		quotesValue()
+	}
+
+	// safeError marks an error of a constraint of this package whose message does not contain the value.
+	safeError struct {
+		error
+	}
+)
+
+var ownPkgPath = reflect.TypeOf(SchemaConstraint{}).PkgPath()
+
+// SensitiveField is a Field for values such as passwords, tokens and card numbers. Its value never appears in the
+// returned errors, and so in Dto, nor in traces, warnings and observer events. Messages of the constraints of this
+// package that only mention their settings, such as "must have 8 characters at least", are kept. Every other message,
+// from Func, Validate, constraints of other packages and constraints that quote the value such as Email or
+// AllowedTransitions, is replaced by a generic one such as "is invalid (json)". Error codes are kept and the errors
+// of a sensitive field do not wrap the original errors.
+func SensitiveField[T any](name string, value T, cons ...CheckableValue[T]) CheckableValue[T] {
+	return &FieldConstraint[T]{
+		Constraint: Value(value, cons...),
+		FieldName:  name,
+		Sensitive:  true,
+	}
+}
+
+func (e safeError) Unwrap() error {
+	return e.error
+}
+
+// redact returns err with a generic message keyed by code in place of every message that is not marked safe,
+// keeping its structure of ErrList and ErrField.
+func redact(err error) error {
+	return redactError(err, false)
+}
+
+func redactError(err error, safe bool) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case safeError:
+		return redactError(e.error, true)
+	case ErrList:
+		r := make(ErrList, len(e))
+		for i, item := range e {
+			r[i] = redactError(item, safe)
+		}
+		return r
+	case ErrField:
+		return ErrField{FieldName: e.FieldName, Err: redactError(e.Err, safe)}
+	case ErrInvalidRune, ErrSyntax:
+		// they point at or quote a part of the value
+		safe = false
+	}
+
+	code := ErrorCode(err)
+	switch {
+	case safe:
+		return ErrConstraint{Code: code, Message: err.Error()}
+	case code == "":
+		return ErrConstraint{Message: "is invalid"}
+	default:
+		return ErrConstraint{Code: code, Message: fmt.Sprintf("is invalid (%s)", code)}
+	}
+}
+
+func (ctx *CheckContext) redact(err error) error {
+	if ctx == nil || !ctx.sensitive {
+		return err
+	}
+
+	return redact(err)
+}
+
+// own marks err of c as safe inside a sensitive field when c is a constraint of this package that does not quote
+// the value.
+func (ctx *CheckContext) own(c any, err error) error {
+	if err == nil || ctx == nil || !ctx.sensitive {
+		return err
+	}
+
+	if _, ok := c.(quotingConstraint); ok {
+		return err
+	}
+
+	t := reflect.TypeOf(c)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.PkgPath() != ownPkgPath {
+		return err
+	}
+
+	return safeError{err}
+}
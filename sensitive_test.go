@@ -0,0 +1,88 @@
+package vee
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSensitiveField(t *testing.T) {
+	const secret = "hunter2 s3cr3t"
+
+	newSchema := func() Checkable {
+		return Schema(
+			Field("user", "bob", NotBlank()),
+			SensitiveField[string]("password", " "+secret+" ", Transform(TrimSpace), Alpha(), Warn(StrMaxLen(5))),
+			SensitiveField("token", `{"a": hunter2}`, ValidJSON()),
+			SensitiveField("email", "alice@payroll-vault.example", Email(EmailDenyDomains("*.example"))),
+			SensitiveField("callback", "https://intranet-gw.example/hook", URL(URLHosts("api.example.com"))),
+			SensitiveField("status", Transition[string]{From: "active", To: "quarantined"},
+				AllowedTransitions(map[string][]string{"active": {"suspended"}})),
+			SensitiveField("card", giftCard{Number: "6035-hunter2"}),
+			SensitiveField("pin", "12", StrLen(4, 4)),
+		)
+	}
+
+	var trace Trace
+	var warnings ErrList
+	o := &countingObserver{errors: map[string]error{}}
+	err := CheckWith(newSchema(), WithSemantic(CheckSemanticAll), WithTrace(&trace), CollectWarnings(&warnings),
+		WithObserver(o))
+	if err == nil {
+		t.Fatal("should get an error but got nil")
+	}
+
+	dto, _ := json.Marshal(err.(ErrList).Dto())
+	traceJSON, _ := json.Marshal(trace)
+	leaks := map[string]string{
+		"error":    err.Error(),
+		"dto":      string(dto),
+		"trace":    trace.String() + string(traceJSON),
+		"warnings": warnings.Error(),
+		"observer": fmt.Sprint(o.errors, o.failures),
+	}
+	fragments := []string{"hunter2", "s3cr3t", "' '", "payroll-vault", "intranet-gw", "quarantined"}
+	for where, text := range leaks {
+		for _, fragment := range fragments {
+			if strings.Contains(text, fragment) {
+				t.Errorf("%s should not contain %q but got:\n%s", where, fragment, text)
+			}
+		}
+	}
+
+	want := "[password: is invalid (character), token: is invalid (json), email: is invalid, callback: is invalid, " +
+		"status: is invalid (transition), card: is invalid, pin: must have 4 characters]"
+	if err.Error() != want {
+		t.Errorf("error should be %q but got %q", want, err.Error())
+	}
+
+	var codes []string
+	for _, e := range collectErrors(err) {
+		codes = append(codes, ErrorCode(e))
+	}
+	if got := strings.Join(codes, ","); got != CodeCharacter+","+CodeJSON+",,,"+CodeTransition+",," {
+		t.Errorf("should keep error codes but got %q", got)
+	}
+
+	if warnings.Error() != "password: must have 5 characters at most" {
+		t.Errorf("should keep messages that only mention settings but got %v", warnings)
+	}
+
+	if err := newSchema().Check(); err == nil || strings.Contains(err.Error(), "hunter2") {
+		t.Errorf("error(%v) should be redacted without CheckWith", err)
+	}
+
+	err = SensitiveField("pin", "12", StrLen(4, 4)).Check()
+	if err == nil || err.Error() != "pin: must have 4 characters" {
+		t.Errorf("error(%v), should keep the message without CheckWith", err)
+	}
+}
+
+type giftCard struct {
+	Number string
+}
+
+func (g giftCard) Validate() error {
+	return fmt.Errorf("card %s has expired", g.Number)
+}
@@ -99,7 +99,7 @@ func NotBlank() CheckableValue[string] {
 
 func IfNotBlank(cons ...CheckableValue[string]) CheckableValue[string] {
 	return &IfNotBlankConstraint{
-		Constraint: Constraints(DefaultCheckSemantic, cons...),
+		Constraint: defaultConstraints(cons),
 	}
 }
 
@@ -231,10 +231,15 @@ func (c *IfNotBlankConstraint) SetValue(value string) {
 }
 
 func (c *IfNotBlankConstraint) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *IfNotBlankConstraint) checkWith(ctx *CheckContext) error {
 	if c.Value != "" {
 		c.Constraint.SetValue(c.Value)
-		return c.Constraint.Check()
+		return ctx.check(c.Constraint)
 	}
 
+	ctx.skip("value is blank")
 	return nil
 }
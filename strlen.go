@@ -0,0 +1,158 @@
+package vee
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+const CodeUTF8 = "utf8"
+
+type (
+	ByteLenConstraint struct {
+		Value     string
+		MinLength int
+		MaxLength int
+	}
+
+	ByteMaxLenConstraint struct {
+		Value     string
+		MaxLength int
+	}
+
+	GraphemeLenConstraint struct {
+		Value     string
+		MinLength int
+		MaxLength int
+	}
+
+	GraphemeMaxLenConstraint struct {
+		Value     string
+		MaxLength int
+	}
+
+	ValidUTF8Constraint struct {
+		Value string
+	}
+)
+
+// ByteLen is like StrLen but counts bytes, which is how many databases limit column and index sizes.
+func ByteLen(min int, max int) CheckableValue[string] {
+	return &ByteLenConstraint{
+		MinLength: min,
+		MaxLength: max,
+	}
+}
+
+func ByteMaxLen(max int) CheckableValue[string] {
+	return &ByteMaxLenConstraint{
+		MaxLength: max,
+	}
+}
+
+// GraphemeLen is like StrLen but counts grapheme clusters, the characters a user perceives, so an emoji with
+// modifiers or a letter with combining marks counts as one.
+func GraphemeLen(min int, max int) CheckableValue[string] {
+	return &GraphemeLenConstraint{
+		MinLength: min,
+		MaxLength: max,
+	}
+}
+
+func GraphemeMaxLen(max int) CheckableValue[string] {
+	return &GraphemeMaxLenConstraint{
+		MaxLength: max,
+	}
+}
+
+// ValidUTF8 checks that the value is valid UTF-8, the length constraints do not check this.
+func ValidUTF8() CheckableValue[string] {
+	return new(ValidUTF8Constraint)
+}
+
+func (c *ByteLenConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *ByteLenConstraint) Check() error {
+	l := len(c.Value)
+	if c.MinLength == c.MaxLength {
+		if l != c.MinLength {
+			return fmt.Errorf("must have %d bytes", c.MaxLength)
+		}
+	}
+
+	if l > c.MaxLength {
+		return fmt.Errorf("must have %d bytes at most", c.MaxLength)
+	} else if l < c.MinLength {
+		return fmt.Errorf("must have %d bytes at least", c.MinLength)
+	}
+
+	return nil
+}
+
+func (c *ByteMaxLenConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *ByteMaxLenConstraint) Check() error {
+	if len(c.Value) > c.MaxLength {
+		return fmt.Errorf("must have %d bytes at most", c.MaxLength)
+	}
+
+	return nil
+}
+
+func (c *GraphemeLenConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *GraphemeLenConstraint) Check() error {
+	l := uniseg.GraphemeClusterCount(c.Value)
+	if c.MinLength == c.MaxLength {
+		if l != c.MinLength {
+			return fmt.Errorf("must have %d characters", c.MaxLength)
+		}
+	}
+
+	if l > c.MaxLength {
+		return fmt.Errorf("must have %d characters at most", c.MaxLength)
+	} else if l < c.MinLength {
+		return fmt.Errorf("must have %d characters at least", c.MinLength)
+	}
+
+	return nil
+}
+
+func (c *GraphemeMaxLenConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *GraphemeMaxLenConstraint) Check() error {
+	if uniseg.GraphemeClusterCount(c.Value) > c.MaxLength {
+		return fmt.Errorf("must have %d characters at most", c.MaxLength)
+	}
+
+	return nil
+}
+
+func (c *ValidUTF8Constraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *ValidUTF8Constraint) Check() error {
+	if utf8.ValidString(c.Value) {
+		return nil
+	}
+
+	for i := 0; i < len(c.Value); {
+		r, size := utf8.DecodeRuneInString(c.Value[i:])
+		if r == utf8.RuneError && size == 1 {
+			return ErrSyntax{Code: CodeUTF8, Message: "invalid UTF-8", Offset: i}
+		}
+		i += size
+	}
+
+	return nil
+}
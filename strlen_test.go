@@ -0,0 +1,20 @@
+package vee
+
+import "testing"
+
+func TestByteAndGraphemeLength(t *testing.T) {
+	const family = "👨‍👩‍👧‍👦" // one grapheme, seven runes, 25 bytes
+
+	var tests = []testCase{
+		{"byte length", Value("héllo", ByteLen(6, 6)), "", false},
+		{"byte length too long", Value("héllo", ByteMaxLen(5)), "", true},
+		{"rune length", Value(family, StrMaxLen(1)), "", true},
+		{"grapheme length", Value(family, GraphemeMaxLen(1)), "", false},
+		{"combining marks", Value("éé", GraphemeLen(2, 2)), "", false},
+		{"grapheme too short", Value("a", GraphemeLen(2, 10)), "", true},
+		{"valid utf8", Value("héllo", ValidUTF8()), "", false},
+		{"invalid utf8", Value("h\xffllo", ValidUTF8()), CodeUTF8, true},
+	}
+
+	runCases(t, tests)
+}
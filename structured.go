@@ -0,0 +1,367 @@
+package vee
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	CodeJSON     = "json"
+	CodeJWT      = "jwt"
+	CodeCron     = "cron"
+	CodeRegex    = "regex"
+	CodeTemplate = "template"
+)
+
+type (
+	JSONConstraint[T any] struct {
+		Value      string
+		Constraint CheckableValue[T]
+	}
+
+	JWTShapeConstraint struct {
+		Value string
+	}
+
+	CronConstraint struct {
+		Value string
+	}
+
+	RegexSyntaxConstraint struct {
+		Value string
+	}
+
+	GoTemplateConstraint struct {
+		Value string
+		Funcs template.FuncMap
+	}
+
+	cronField struct {
+		name  string
+		min   int
+		max   int
+		names map[string]int
+	}
+)
+
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+
+	cronDayNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+
+	cronFields = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: cronMonthNames},
+		{name: "day of week", min: 0, max: 7, names: cronDayNames},
+	}
+
+	cronSecondField = cronField{name: "second", min: 0, max: 59}
+
+	cronMacros = map[string]bool{
+		"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+		"@daily": true, "@midnight": true, "@hourly": true,
+	}
+
+	templateLineRegex = regexp.MustCompile(`^template: [^:]*:(\d+):`)
+)
+
+// ValidJSON checks that the value is a single well-formed JSON value.
+func ValidJSON() CheckableValue[string] {
+	return JSONDocument[any]()
+}
+
+// JSONDocument checks that the value is JSON that decodes into T and then checks the decoded document against cons,
+// a document implementing Validatable is validated recursively.
+func JSONDocument[T any](cons ...CheckableValue[T]) CheckableValue[string] {
+	return &JSONConstraint[T]{
+		Constraint: defaultConstraints(cons),
+	}
+}
+
+// JWTShape checks that the value looks like a compact JWS: three base64url segments where the header and the claims
+// decode to JSON objects and the header has an "alg". The signature is not verified.
+func JWTShape() CheckableValue[string] {
+	return new(JWTShapeConstraint)
+}
+
+// CronExpr checks a cron expression of 5 fields (minute, hour, day of month, month, day of week) or 6 fields with a
+// leading second field. Fields accept *, ?, values, ranges, steps, lists and month and day names, the common
+// macros such as @daily are accepted too.
+func CronExpr() CheckableValue[string] {
+	return new(CronConstraint)
+}
+
+// RegexSyntax checks that the value compiles as a Go (RE2) regular expression.
+func RegexSyntax() CheckableValue[string] {
+	return new(RegexSyntaxConstraint)
+}
+
+// GoTemplate checks that the value parses as a text/template, funcs declares the functions the template may call.
+func GoTemplate(funcs ...template.FuncMap) CheckableValue[string] {
+	c := &GoTemplateConstraint{
+		Funcs: template.FuncMap{},
+	}
+	for _, fm := range funcs {
+		for name, f := range fm {
+			c.Funcs[name] = f
+		}
+	}
+
+	return c
+}
+
+func (c *JSONConstraint[T]) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *JSONConstraint[T]) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *JSONConstraint[T]) checkWith(ctx *CheckContext) error {
+	var doc T
+	if err := json.Unmarshal([]byte(c.Value), &doc); err != nil {
+		return jsonError(c.Value, err)
+	}
+
+	c.Constraint.SetValue(doc)
+	return ctx.check(c.Constraint)
+}
+
+func (c *JWTShapeConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *JWTShapeConstraint) Check() error {
+	segments := strings.Split(c.Value, ".")
+	if len(segments) != 3 {
+		return ErrSyntax{Code: CodeJWT, Message: fmt.Sprintf("invalid JWT, must have 3 segments but has %d", len(segments))}
+	}
+
+	offset := 0
+	for i, name := range []string{"header", "claims", "signature"} {
+		seg := segments[i]
+		b, err := base64.RawURLEncoding.DecodeString(seg)
+		if err != nil {
+			var ce base64.CorruptInputError
+			pos := offset
+			if errors.As(err, &ce) {
+				pos += int(ce)
+			}
+			return ErrSyntax{Code: CodeJWT, Message: fmt.Sprintf("invalid JWT %s, not base64url", name), Offset: pos}
+		}
+
+		if name != "signature" {
+			var obj map[string]any
+			if seg == "" || json.Unmarshal(b, &obj) != nil || obj == nil {
+				return ErrSyntax{Code: CodeJWT, Message: fmt.Sprintf("invalid JWT %s, not a JSON object", name), Offset: offset}
+			}
+
+			if _, ok := obj["alg"].(string); name == "header" && !ok {
+				return ErrSyntax{Code: CodeJWT, Message: "invalid JWT header, missing alg", Offset: offset}
+			}
+		}
+
+		offset += len(seg) + 1
+	}
+
+	return nil
+}
+
+func (c *CronConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *CronConstraint) Check() error {
+	if cronMacros[strings.TrimSpace(c.Value)] {
+		return nil
+	}
+
+	fields, offsets := splitFields(c.Value)
+	specs := cronFields
+	switch len(fields) {
+	case 5:
+	case 6:
+		specs = append([]cronField{cronSecondField}, cronFields...)
+	default:
+		return ErrSyntax{
+			Code:    CodeCron,
+			Message: fmt.Sprintf("invalid cron expression, must have 5 or 6 fields but has %d", len(fields)),
+		}
+	}
+
+	for i, f := range fields {
+		if err := specs[i].check(f); err != nil {
+			return ErrSyntax{
+				Code:    CodeCron,
+				Message: fmt.Sprintf("invalid cron expression, %s field: %v", specs[i].name, err),
+				Offset:  offsets[i],
+				Line:    1,
+				Column:  offsets[i] + 1,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *RegexSyntaxConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *RegexSyntaxConstraint) Check() error {
+	_, err := regexp.Compile(c.Value)
+	if err == nil {
+		return nil
+	}
+
+	var se *syntax.Error
+	if errors.As(err, &se) {
+		offset := strings.Index(c.Value, se.Expr)
+		if offset < 0 {
+			offset = 0
+		}
+		return ErrSyntax{Code: CodeRegex, Message: fmt.Sprintf("invalid regex, %s: `%s`", se.Code, se.Expr), Offset: offset}
+	}
+
+	return ErrSyntax{Code: CodeRegex, Message: fmt.Sprintf("invalid regex, %v", err)}
+}
+
+func (c *GoTemplateConstraint) SetValue(value string) {
+	c.Value = value
+}
+
+func (c *GoTemplateConstraint) Check() error {
+	_, err := template.New("").Funcs(c.Funcs).Parse(c.Value)
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	line := 0
+	if m := templateLineRegex.FindStringSubmatch(msg); m != nil {
+		line, _ = strconv.Atoi(m[1])
+		msg = strings.TrimSpace(msg[len(m[0]):])
+	}
+
+	return ErrSyntax{Code: CodeTemplate, Message: fmt.Sprintf("invalid template, %s", msg), Line: line}
+}
+
+func (f cronField) check(field string) error {
+	if field == "?" && (f.name == "day of month" || f.name == "day of week") {
+		return nil
+	}
+
+	for _, item := range strings.Split(field, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 || n > f.max {
+				return fmt.Errorf("invalid step %q", step)
+			}
+		}
+
+		if base == "*" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(base, "-")
+		lo, err := f.value(from)
+		if err != nil {
+			return err
+		}
+
+		if isRange {
+			hi, err := f.value(to)
+			if err != nil {
+				return err
+			} else if lo > hi {
+				return fmt.Errorf("invalid range %q", base)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(s)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || !isDigits(s) {
+		return 0, fmt.Errorf("invalid value %q", s)
+	} else if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%d is out of range %d-%d", n, f.min, f.max)
+	}
+
+	return n, nil
+}
+
+// splitFields splits s around spaces and tabs like strings.Fields and also returns the byte offset of each field.
+func splitFields(s string) ([]string, []int) {
+	var fields []string
+	var offsets []int
+	start := -1
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == ' ' || s[i] == '\t' {
+			if start >= 0 {
+				fields = append(fields, s[start:i])
+				offsets = append(offsets, start)
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+
+	return fields, offsets
+}
+
+func jsonError(doc string, err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	offset := 0
+	switch {
+	case errors.As(err, &syntaxErr):
+		// the offset is just after the offending byte
+		offset = int(syntaxErr.Offset) - 1
+	case errors.As(err, &typeErr):
+		offset = int(typeErr.Offset)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	line, column := lineColumn(doc, offset)
+	msg := strings.TrimPrefix(err.Error(), "json: ")
+	return ErrSyntax{Code: CodeJSON, Message: fmt.Sprintf("invalid JSON, %s", msg), Offset: offset, Line: line, Column: column}
+}
+
+// lineColumn converts a byte offset in s to a one based line and column.
+func lineColumn(s string, offset int) (int, int) {
+	if offset > len(s) {
+		offset = len(s)
+	}
+
+	before := []byte(s[:offset])
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(before, '\n')
+	return line, column
+}
@@ -0,0 +1,293 @@
+package vee
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	TracePass    = "pass"
+	TraceFail    = "fail"
+	TraceSkipped = "skipped"
+
+	redacted = "[redacted]"
+)
+
+type (
+	// Trace is the tree of constraints evaluated by a CheckWith call, see WithTrace. String renders it as a tree and
+	// it marshals to JSON as is.
+	Trace []*TraceNode
+
+	// TraceNode is one evaluated constraint. Path is the dotted path of the field being checked, Params holds the
+	// exported settings of the constraint and Reason says why a condition skipped it.
+	TraceNode struct {
+		Path       string            `json:"path,omitempty"`
+		Constraint string            `json:"constraint"`
+		Params     map[string]string `json:"params,omitempty"`
+		Value      string            `json:"value,omitempty"`
+		Result     string            `json:"result"`
+		Error      string            `json:"error,omitempty"`
+		Reason     string            `json:"reason,omitempty"`
+		Children   []*TraceNode      `json:"children,omitempty"`
+	}
+
+	tracer struct {
+		trace *Trace
+		stack []*TraceNode
+	}
+
+	// traced is implemented by constraints that name themselves in a trace or are left out of it.
+	traced interface {
+		traceName() string
+	}
+
+	// namedField is implemented by Field, its node is recorded at the path of the field.
+	namedField interface {
+		fieldName() string
+	}
+)
+
+// WithTrace records every constraint evaluated by the call into dst. Values are recorded as text, use Redacted
+// before writing a trace to an audit log. Tracing reads the settings of constraints with reflection, it is meant
+// for debugging and auditing and not for every request.
+func WithTrace(dst *Trace) CheckOption {
+	return func(ctx *CheckContext) {
+		ctx.tracer = &tracer{trace: dst}
+	}
+}
+
+// Redacted returns a copy of the trace without values.
+func (t Trace) Redacted() Trace {
+	r := make(Trace, len(t))
+	for i, n := range t {
+		c := *n
+		if c.Value != "" {
+			c.Value = redacted
+		}
+		c.Children = Trace(n.Children).Redacted()
+		r[i] = &c
+	}
+
+	return r
+}
+
+func (t Trace) String() string {
+	var sb strings.Builder
+	t.render(&sb, "")
+	return sb.String()
+}
+
+func (t Trace) render(sb *strings.Builder, indent string) {
+	for i, n := range t {
+		branch, next := "├── ", "│   "
+		if i == len(t)-1 {
+			branch, next = "└── ", "    "
+		}
+
+		sb.WriteString(indent)
+		sb.WriteString(branch)
+		sb.WriteString(n.line())
+		sb.WriteString("\n")
+		Trace(n.Children).render(sb, indent+next)
+	}
+}
+
+func (n *TraceNode) line() string {
+	var sb strings.Builder
+	sb.WriteString(n.Constraint)
+	if len(n.Params) > 0 {
+		keys := make([]string, 0, len(n.Params))
+		for k := range n.Params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		sb.WriteString("(")
+		for i, k := range keys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "%s=%s", k, n.Params[k])
+		}
+		sb.WriteString(")")
+	}
+
+	if n.Path != "" {
+		fmt.Fprintf(&sb, " at %s", n.Path)
+	}
+	if n.Value != "" {
+		fmt.Fprintf(&sb, " value=%s", n.Value)
+	}
+
+	fmt.Fprintf(&sb, " -> %s", n.Result)
+	if n.Error != "" {
+		fmt.Fprintf(&sb, ": %s", n.Error)
+	} else if n.Reason != "" {
+		fmt.Fprintf(&sb, ", %s", n.Reason)
+	}
+
+	return sb.String()
+}
+
+// check checks c recording a node for it, the nodes of nested constraints become its children.
+func (t *tracer) check(ctx *CheckContext, c Checkable) error {
+	name := constraintName(c)
+	if name == "" {
+		return ctx.checkUntraced(c)
+	}
+
+	return t.record(ctx, name, c, func() error {
+		return ctx.checkUntraced(c)
+	})
+}
+
+func (t *tracer) record(ctx *CheckContext, name string, c any, check func() error) error {
+	n := &TraceNode{
+		Path:       ctx.path,
+		Constraint: name,
+		Result:     TracePass,
+	}
+
+	switch f := c.(type) {
+	case namedField:
+		n.Path = joinPath(ctx.path, f.fieldName())
+	case traced:
+		_, n.Value = inspect(c)
+	default:
+		n.Params, n.Value = inspect(c)
+	}
+
+	if len(t.stack) == 0 {
+		*t.trace = append(*t.trace, n)
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Children = append(parent.Children, n)
+	}
+
+	t.stack = append(t.stack, n)
+	err := check()
+	t.stack = t.stack[:len(t.stack)-1]
+
+	if err != nil {
+		n.Result = TraceFail
+		n.Error = ctx.redact(err).Error()
+	}
+
+	if ctx.sensitive && n.Value != "" {
+		n.Value = redacted
+	}
+
+	return err
+}
+
+// skip marks the constraint being checked as skipped by a condition.
+func (ctx *CheckContext) skip(reason string) {
+	if ctx == nil || ctx.tracer == nil || len(ctx.tracer.stack) == 0 {
+		return
+	}
+
+	n := ctx.tracer.stack[len(ctx.tracer.stack)-1]
+	n.Result = TraceSkipped
+	n.Reason = reason
+}
+
+// constraintName names c after its type without the package, type parameters and Constraint suffix, such as "StrLen"
+// for *StrLenConstraint, it returns "" for constraints left out of traces.
+func constraintName(c any) string {
+	if t, ok := c.(traced); ok {
+		return t.traceName()
+	}
+
+	name := reflect.TypeOf(c).String()
+	name = strings.TrimPrefix(name, "*")
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+	name = strings.TrimPrefix(name, "vee.")
+
+	return strings.TrimSuffix(name, "Constraint")
+}
+
+// inspect returns the exported settings of c and its value as text, other than nested constraints and funcs.
+func inspect(c any) (map[string]string, string) {
+	v := reflect.ValueOf(c)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, ""
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, ""
+	}
+
+	var params map[string]string
+	value := ""
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		if f.Name == "Value" {
+			value = formatTraceValue(v.Field(i))
+			continue
+		}
+
+		if s, ok := formatParam(v.Field(i)); ok {
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params[f.Name] = s
+		}
+	}
+
+	return params, value
+}
+
+func formatTraceValue(v reflect.Value) string {
+	if v.Kind() == reflect.String {
+		return strconv.Quote(v.String())
+	}
+
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return "nil"
+	}
+
+	return fmt.Sprintf("%v", v.Interface())
+}
+
+// formatParam formats settings of basic types, collections of them and fmt.Stringers.
+func formatParam(v reflect.Value) (string, bool) {
+	if v.CanInterface() {
+		if s, ok := v.Interface().(fmt.Stringer); ok && !(v.Kind() == reflect.Pointer && v.IsNil()) {
+			return s.String(), true
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String()), true
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", v.Interface()), true
+	case reflect.Slice, reflect.Array:
+		if isBasicKind(v.Type().Elem().Kind()) {
+			return fmt.Sprintf("%v", v.Interface()), true
+		}
+	case reflect.Map:
+		if isBasicKind(v.Type().Key().Kind()) && isBasicKind(v.Type().Elem().Kind()) {
+			return fmt.Sprintf("%v", v.Interface()), true
+		}
+	}
+
+	return "", false
+}
+
+func isBasicKind(k reflect.Kind) bool {
+	return k >= reflect.Bool && k <= reflect.Float64 || k == reflect.String
+}
@@ -0,0 +1,75 @@
+package vee
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	var nickname *string
+	schema := Schema(
+		Field("name", "bob", NotBlank(), StrLen(1, 10)),
+		Field("email", "", IfNotBlank(Email())),
+		Field("nickname", nickname, IfNotNil[*string](StrMaxLen(20))),
+		Field("age", 15, If(func() bool { return true }, Min(18))),
+	)
+
+	var trace Trace
+	err := CheckWith(schema, WithTrace(&trace), WithSemantic(CheckSemanticAll))
+	if err == nil {
+		t.Fatal("should get an error but got nil")
+	}
+
+	if len(trace) != 1 || trace[0].Constraint != "Schema" || trace[0].Result != TraceFail {
+		t.Fatalf("should trace a failed Schema but got:\n%v", trace)
+	}
+
+	fields := trace[0].Children
+	if len(fields) != 4 {
+		t.Fatalf("should trace 4 fields but got:\n%v", trace)
+	}
+
+	name := fields[0].Children
+	if len(name) != 2 || name[1].Constraint != "StrLen" || name[1].Path != "name" || name[1].Value != `"bob"` ||
+		name[1].Params["MinLength"] != "1" || name[1].Params["MaxLength"] != "10" {
+		t.Errorf("should trace StrLen with its parameters but got:\n%v", trace)
+	}
+
+	if n := fields[1].Children[0]; n.Constraint != "IfNotBlank" || n.Result != TraceSkipped || n.Children != nil {
+		t.Errorf("should trace a skipped IfNotBlank but got:\n%v", trace)
+	}
+
+	if n := fields[2].Children[0]; n.Constraint != "IfNotNil" || n.Result != TraceSkipped {
+		t.Errorf("should trace a skipped IfNotNil but got:\n%v", trace)
+	}
+
+	if n := fields[3].Children[0]; n.Result != TraceFail || n.Children[0].Constraint != "Min" {
+		t.Errorf("should trace a failed If but got:\n%v", trace)
+	}
+
+	tree := trace.String()
+	if !strings.Contains(tree, `└── Field at age -> fail`) ||
+		!strings.Contains(tree, `IfNotBlank at email value="" -> skipped, value is blank`) {
+		t.Errorf("should render a tree but got:\n%s", tree)
+	}
+
+	b, err := json.Marshal(trace.Redacted())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "bob") || !strings.Contains(string(b), `"constraint":"StrLen"`) {
+		t.Errorf("should marshal a redacted trace but got %s", b)
+	}
+
+	// a nested Schematic value is checked once
+	var nested Trace
+	_ = CheckWith(Schema(Field("address", schemaAddress{Street: "Main St", City: "Ottawa"})), WithTrace(&nested))
+	tree = nested.String()
+	if n := strings.Count(tree, "Func at address"); n != 1 {
+		t.Errorf("should trace the nested schema once but got %d times:\n%s", n, tree)
+	}
+	if n := strings.Count(tree, "NotBlank at address.street"); n != 1 {
+		t.Errorf("should trace the nested fields once but got %d times:\n%s", n, tree)
+	}
+}
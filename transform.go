@@ -0,0 +1,94 @@
+package vee
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type (
+	// Transformer is a constraint that rewrites the value, in a chain built by Value, Field or Constraints the
+	// constraints after it get the transformed value.
+	Transformer[T any] interface {
+		CheckableValue[T]
+		Transform(value T) T
+	}
+
+	TransformConstraint[T any] struct {
+		Value  T
+		Funcs  []func(T) T
+		Result *T
+	}
+)
+
+// Transform applies fns in order to the value and passes the result to the constraints that follow it, it never
+// fails on its own.
+func Transform[T any](fns ...func(T) T) CheckableValue[T] {
+	return &TransformConstraint[T]{
+		Funcs: fns,
+	}
+}
+
+// Sanitize is like Transform and also stores the transformed value in dst so the caller can persist it. Inside Each
+// dst holds the last item.
+func Sanitize[T any](dst *T, fns ...func(T) T) CheckableValue[T] {
+	return &TransformConstraint[T]{
+		Funcs:  fns,
+		Result: dst,
+	}
+}
+
+// TrimSpace removes leading and trailing white space.
+func TrimSpace(s string) string {
+	return strings.TrimSpace(s)
+}
+
+func ToLower(s string) string {
+	return strings.ToLower(s)
+}
+
+func ToUpper(s string) string {
+	return strings.ToUpper(s)
+}
+
+// CollapseWhitespace trims s and replaces each run of white space inside it with a single space.
+func CollapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// StripControl removes control characters other than tab, line feed and carriage return.
+func StripControl(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// NFC converts s to Unicode normalization form C.
+func NFC(s string) string {
+	return norm.NFC.String(s)
+}
+
+func (c *TransformConstraint[T]) SetValue(value T) {
+	c.Transform(value)
+}
+
+func (c *TransformConstraint[T]) Transform(value T) T {
+	for _, f := range c.Funcs {
+		value = f(value)
+	}
+
+	c.Value = value
+	if c.Result != nil {
+		*c.Result = value
+	}
+
+	return value
+}
+
+func (c *TransformConstraint[T]) Check() error {
+	return nil
+}
@@ -0,0 +1,34 @@
+package vee
+
+import "testing"
+
+func TestTransform(t *testing.T) {
+	var email string
+	err := Value(" Bob@Example.COM ", Sanitize(&email, TrimSpace, ToLower), Email(), StrMaxLen(15)).Check()
+	if err != nil {
+		t.Errorf("error(%v), should get nil but got error", err)
+	}
+	if email != "bob@example.com" {
+		t.Errorf("sanitized value should be %q but got %q", "bob@example.com", email)
+	}
+
+	var name string
+	err = Value("  Jane \t\x00 Doe ", Sanitize(&name, StripControl, CollapseWhitespace, NFC), StrMaxLen(8)).Check()
+	if err != nil {
+		t.Errorf("error(%v), should get nil but got error", err)
+	}
+	if name != "Jane Doe" {
+		t.Errorf("sanitized value should be %q but got %q", "Jane Doe", name)
+	}
+
+	var tests = []testCase{
+		{"transformed value is checked", Value("  ", Transform(TrimSpace), NotBlank()), "", true},
+		{"untransformed value is checked", Value(" a ", StrMaxLen(1), Transform(TrimSpace)), "", true},
+		{"nested transform", Value(" a ", First(Transform(TrimSpace)), StrMaxLen(1)), "", false},
+		{"custom func", Value(-5, Transform(func(n int) int { return n * n }), Positive[int]()), "", false},
+		{"field", Schema(Field("code", " de ", Transform(TrimSpace, ToUpper), CountryCode())), "", false},
+		{"each", Value([]string{" a", "b "}, Each[[]string](Transform(TrimSpace), StrMaxLen(1))), "", false},
+	}
+
+	runCases(t, tests)
+}
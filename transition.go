@@ -0,0 +1,103 @@
+package vee
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	CodeImmutable  = "immutable"
+	CodeDecrease   = "decrease"
+	CodeTransition = "transition"
+)
+
+type (
+	// Transition is a change of a value from a stored version to an updated one.
+	Transition[T any] struct {
+		From T
+		To   T
+	}
+
+	ImmutableConstraint[T comparable] struct {
+		Value Transition[T]
+	}
+
+	OnlyIncreaseConstraint[T constraints.Ordered] struct {
+		Value Transition[T]
+	}
+
+	AllowedTransitionsConstraint[T comparable] struct {
+		Value       Transition[T]
+		Transitions map[T]map[T]bool
+	}
+)
+
+// Diff is a Field checking the change of a field from one value to another against cons, such as Immutable.
+func Diff[T any](name string, from, to T, cons ...CheckableValue[Transition[T]]) CheckableValue[Transition[T]] {
+	return Field(name, Transition[T]{From: from, To: to}, cons...)
+}
+
+// Immutable checks that the value did not change.
+func Immutable[T comparable]() CheckableValue[Transition[T]] {
+	return new(ImmutableConstraint[T])
+}
+
+// OnlyIncrease checks that the value did not decrease, an unchanged value is accepted.
+func OnlyIncrease[T constraints.Ordered]() CheckableValue[Transition[T]] {
+	return new(OnlyIncreaseConstraint[T])
+}
+
+// AllowedTransitions checks that the value moved from a state to one of the states transitions lists for it, an
+// unchanged value is accepted.
+func AllowedTransitions[T comparable](transitions map[T][]T) CheckableValue[Transition[T]] {
+	c := &AllowedTransitionsConstraint[T]{
+		Transitions: make(map[T]map[T]bool, len(transitions)),
+	}
+	for from, to := range transitions {
+		c.Transitions[from] = valueSet(to)
+	}
+
+	return c
+}
+
+func (c *ImmutableConstraint[T]) SetValue(value Transition[T]) {
+	c.Value = value
+}
+
+func (c *ImmutableConstraint[T]) Check() error {
+	if c.Value.From != c.Value.To {
+		return ConstraintError(CodeImmutable, "cannot be changed")
+	}
+
+	return nil
+}
+
+func (c *OnlyIncreaseConstraint[T]) quotesValue() {}
+
+func (c *OnlyIncreaseConstraint[T]) SetValue(value Transition[T]) {
+	c.Value = value
+}
+
+func (c *OnlyIncreaseConstraint[T]) Check() error {
+	if c.Value.To < c.Value.From {
+		return ConstraintError(CodeDecrease, fmt.Sprintf("cannot be less than the current %v", c.Value.From))
+	}
+
+	return nil
+}
+
+func (c *AllowedTransitionsConstraint[T]) quotesValue() {}
+
+func (c *AllowedTransitionsConstraint[T]) SetValue(value Transition[T]) {
+	c.Value = value
+}
+
+func (c *AllowedTransitionsConstraint[T]) Check() error {
+	from, to := c.Value.From, c.Value.To
+	if from != to && !c.Transitions[from][to] {
+		return ConstraintError(CodeTransition, fmt.Sprintf("cannot change from %v to %v", from, to))
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package vee
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type document struct {
+	CreatedBy string
+	Version   int
+	Status    string
+}
+
+var documentTransitions = map[string][]string{
+	"draft":  {"review"},
+	"review": {"draft", "published"},
+}
+
+func (d document) ValidateUpdate(old document) error {
+	return Schema(
+		Diff("created_by", old.CreatedBy, d.CreatedBy, Immutable[string]()),
+		Diff("version", old.Version, d.Version, OnlyIncrease[int]()),
+		Diff("status", old.Status, d.Status, AllowedTransitions(documentTransitions)),
+	).Check()
+}
+
+func TestTransitions(t *testing.T) {
+	old := document{CreatedBy: "bob", Version: 2, Status: "draft"}
+
+	var tests = []struct {
+		name  string
+		new   document
+		field string
+		code  string
+	}{
+		{"unchanged", old, "", ""},
+		{"allowed", document{CreatedBy: "bob", Version: 3, Status: "review"}, "", ""},
+		{"immutable", document{CreatedBy: "eve", Version: 2, Status: "draft"}, "created_by", CodeImmutable},
+		{"decrease", document{CreatedBy: "bob", Version: 1, Status: "draft"}, "version", CodeDecrease},
+		{"skipped state", document{CreatedBy: "bob", Version: 2, Status: "published"}, "status", CodeTransition},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.new.ValidateUpdate(old)
+			if test.field == "" {
+				if err != nil {
+					t.Errorf("error(%v), should get nil but got error", err)
+				}
+				return
+			}
+
+			var ef ErrField
+			if !errors.As(err, &ef) || ef.FieldName != test.field || ErrorCode(err) != test.code {
+				t.Errorf("error(%v), should be %q on field %q", err, test.code, test.field)
+			}
+		})
+	}
+
+	err := Value(Transition[string]{"published", "draft"}, AllowedTransitions(documentTransitions)).Check()
+	if err == nil || !strings.Contains(err.Error(), "from published to draft") {
+		t.Errorf("error(%v), should name both states", err)
+	}
+}
@@ -1,6 +1,7 @@
 package vee
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -16,6 +17,26 @@ type (
 		FieldName string
 		Err       error
 	}
+
+	ErrConstraint struct {
+		Code    string
+		Message string
+	}
+
+	// ErrSyntax is returned by constraints that parse the value, Offset is zero based while Line and Column are one
+	// based, zero when unknown.
+	ErrSyntax struct {
+		Code    string
+		Message string
+		Offset  int
+		Line    int
+		Column  int
+	}
+
+	codedError interface {
+		error
+		errorCode() string
+	}
 )
 
 func (el ErrList) Error() string {
@@ -50,13 +71,63 @@ func (el ErrList) Dto() []map[string]string {
 	return errMap
 }
 
+func (el ErrList) Unwrap() []error {
+	return el
+}
+
 func (ef ErrField) Error() string {
 	return fmt.Sprintf("%s: %v", ef.FieldName, ef.Err)
 }
 
+func (ef ErrField) Unwrap() error {
+	return ef.Err
+}
+
 func FieldError(name string, err error) error {
 	return ErrField{
 		FieldName: name,
 		Err:       err,
 	}
 }
+
+func (ec ErrConstraint) Error() string {
+	return ec.Message
+}
+
+func (ec ErrConstraint) errorCode() string {
+	return ec.Code
+}
+
+func (es ErrSyntax) Error() string {
+	switch {
+	case es.Line > 0 && es.Column > 0:
+		return fmt.Sprintf("%s at line %d, column %d", es.Message, es.Line, es.Column)
+	case es.Line > 0:
+		return fmt.Sprintf("%s at line %d", es.Message, es.Line)
+	default:
+		return fmt.Sprintf("%s at offset %d", es.Message, es.Offset)
+	}
+}
+
+func (es ErrSyntax) errorCode() string {
+	return es.Code
+}
+
+// ConstraintError returns an error carrying a code that identifies the failed constraint, the message is meant for
+// humans while the code is stable and meant for programs.
+func ConstraintError(code, message string) error {
+	return ErrConstraint{
+		Code:    code,
+		Message: message,
+	}
+}
+
+// ErrorCode returns the code of the first ErrConstraint or ErrSyntax found in err's chain, or "" if there is none.
+func ErrorCode(err error) string {
+	var ce codedError
+	if errors.As(err, &ce) {
+		return ce.errorCode()
+	}
+
+	return ""
+}
@@ -247,3 +247,57 @@ func TestSchema(t *testing.T) {
 		t.Fail()
 	}
 }
+
+// collectErrors flattens ErrList and ErrField into the errors they hold.
+func collectErrors(err error) []error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case ErrList:
+		var errs []error
+		for _, item := range e {
+			errs = append(errs, collectErrors(item)...)
+		}
+		return errs
+	case ErrField:
+		return collectErrors(e.Err)
+	default:
+		return []error{err}
+	}
+}
+
+// testCase is a check and its expected result, code is the ErrorCode the error should have when fails is set.
+type testCase struct {
+	name  string
+	check Checkable
+	code  string
+	fails bool
+}
+
+func runCases(t *testing.T, tests []testCase) {
+	t.Helper()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("panic(%v)", r)
+				}
+			}()
+
+			err := test.check.Check()
+			if err == nil && test.fails {
+				t.Errorf("should get an error but got nil")
+				return
+			}
+
+			if err != nil && !test.fails {
+				t.Errorf("error(%v), should get nil but got error", err)
+				return
+			}
+
+			if code := ErrorCode(err); test.fails && code != test.code {
+				t.Errorf("error(%v), should have code %q but got %q", err, test.code, code)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Package veeconfig loads settings from environment variables and flags into a struct and checks them with vee,
+// reporting every missing or invalid setting at once.
+package veeconfig
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v "github.com/kumait/vee"
+)
+
+const CodeRequired = "config_required"
+
+const secretMask = "******"
+
+type (
+	// Setting describes where a setting is read from. A flag given on the command line takes precedence over the
+	// environment variable, which takes precedence over Default. An empty environment variable counts as unset.
+	Setting struct {
+		Env      string
+		Flag     string
+		Usage    string
+		Default  string
+		Required bool
+
+		// Secret masks the value in the report, the setting is checked as a vee.SensitiveField.
+		Secret bool
+	}
+
+	Loader struct {
+		lookupEnv func(string) (string, bool)
+		flags     *flag.FlagSet
+		settings  []*binding
+	}
+
+	Option func(*Loader)
+
+	// SettingError is a missing or invalid setting, Value is masked for secrets and "" if the setting was not given.
+	SettingError struct {
+		Setting Setting
+		Value   string
+		Err     error
+	}
+
+	// Report lists every missing or invalid setting in the order they were defined, its Error is meant to be
+	// printed as is at startup.
+	Report []SettingError
+
+	binding struct {
+		Setting
+		flagValue *string
+
+		// isBool makes the flag a boolean flag, given alone such as -debug.
+		isBool bool
+
+		// bind parses raw into the destination, when given, and returns the field to check.
+		bind func(raw string, given bool) (v.Checkable, error)
+	}
+)
+
+// New returns a Loader reading the environment with os.LookupEnv.
+func New(opts ...Option) *Loader {
+	l := &Loader{
+		lookupEnv: os.LookupEnv,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// Flags registers a flag on fs for each setting with a Flag name, fs must be parsed before Load.
+func Flags(fs *flag.FlagSet) Option {
+	return func(l *Loader) {
+		l.flags = fs
+	}
+}
+
+// LookupEnv replaces os.LookupEnv, which is useful in tests.
+func LookupEnv(lookup func(string) (string, bool)) Option {
+	return func(l *Loader) {
+		l.lookupEnv = lookup
+	}
+}
+
+// Var defines a setting parsed by parse into dst and checked against cons. When the setting is not given and has no
+// default dst keeps its value, which is checked too.
+func Var[T any](l *Loader, dst *T, s Setting, parse func(string) (T, error), cons ...v.CheckableValue[T]) {
+	define(l, dst, s, parse, "invalid value", cons)
+}
+
+func String(l *Loader, dst *string, s Setting, cons ...v.CheckableValue[string]) {
+	parse := func(s string) (string, error) {
+		return s, nil
+	}
+
+	define(l, dst, s, parse, "", cons)
+}
+
+func Int(l *Loader, dst *int, s Setting, cons ...v.CheckableValue[int]) {
+	define(l, dst, s, strconv.Atoi, "must be an integer", cons)
+}
+
+func Float(l *Loader, dst *float64, s Setting, cons ...v.CheckableValue[float64]) {
+	parse := func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	}
+
+	define(l, dst, s, parse, "must be a number", cons)
+}
+
+func Bool(l *Loader, dst *bool, s Setting, cons ...v.CheckableValue[bool]) {
+	define(l, dst, s, strconv.ParseBool, "must be true or false", cons)
+}
+
+func Duration(l *Loader, dst *time.Duration, s Setting, cons ...v.CheckableValue[time.Duration]) {
+	define(l, dst, s, time.ParseDuration, "must be a duration such as 1h30m", cons)
+}
+
+// Load reads every setting into its destination and checks all of them in all-errors mode, the error is a Report.
+func (l *Loader) Load() error {
+	var report Report
+	var fields []v.Checkable
+	byName := make(map[string]*binding, len(l.settings))
+	for _, b := range l.settings {
+		byName[b.name()] = b
+		raw, given := l.raw(b)
+		if !given && b.Required {
+			report = append(report, b.error("", false, v.ConstraintError(CodeRequired, "is required")))
+			continue
+		}
+
+		field, err := b.bind(raw, given)
+		if err != nil {
+			report = append(report, b.error(raw, given, err))
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	err := v.CheckWith(v.Schema(fields...), v.WithSemantic(v.CheckSemanticAll))
+	if el, ok := err.(v.ErrList); ok {
+		for _, e := range el {
+			if ef, ok := e.(v.ErrField); ok {
+				b := byName[ef.FieldName]
+				raw, given := l.raw(b)
+				report = append(report, b.error(raw, given, ef.Err))
+			}
+		}
+	}
+
+	if report == nil {
+		return nil
+	}
+
+	report.sort(l.settings)
+	return report
+}
+
+func (r Report) Error() string {
+	var sb strings.Builder
+	sb.WriteString("invalid configuration:")
+	for _, e := range r {
+		sb.WriteString("\n  ")
+		sb.WriteString(e.Error())
+	}
+
+	return sb.String()
+}
+
+func (r Report) Unwrap() []error {
+	errs := make([]error, len(r))
+	for i, e := range r {
+		errs[i] = e
+	}
+
+	return errs
+}
+
+func (e SettingError) Error() string {
+	var sb strings.Builder
+	s := e.Setting
+	switch {
+	case s.Env != "" && s.Flag != "":
+		fmt.Fprintf(&sb, "%s (-%s)", s.Env, s.Flag)
+	case s.Env != "":
+		sb.WriteString(s.Env)
+	default:
+		fmt.Fprintf(&sb, "-%s", s.Flag)
+	}
+
+	if e.Value != "" {
+		if s.Secret {
+			fmt.Fprintf(&sb, " = %s", e.Value)
+		} else {
+			fmt.Fprintf(&sb, " = %q", e.Value)
+		}
+	}
+
+	fmt.Fprintf(&sb, ": %v", e.Err)
+	return sb.String()
+}
+
+func (e SettingError) Unwrap() error {
+	return e.Err
+}
+
+func define[T any](l *Loader, dst *T, s Setting, parse func(string) (T, error), message string,
+	cons []v.CheckableValue[T]) {
+	_, isBool := any(dst).(*bool)
+	b := &binding{Setting: s, isBool: isBool}
+	b.bind = func(raw string, given bool) (v.Checkable, error) {
+		if given {
+			value, err := parse(raw)
+			if err != nil {
+				return nil, v.ParseError(err, message)
+			}
+			*dst = value
+		}
+
+		if s.Secret {
+			return v.SensitiveField(b.name(), *dst, cons...), nil
+		}
+		return v.Field(b.name(), *dst, cons...), nil
+	}
+
+	if l.flags != nil && s.Flag != "" {
+		usage := s.Usage
+		if s.Env != "" {
+			usage = strings.TrimSpace(fmt.Sprintf("%s (env %s)", usage, s.Env))
+		}
+
+		l.flags.Var(b, s.Flag, usage)
+	}
+
+	l.settings = append(l.settings, b)
+}
+
+func (l *Loader) raw(b *binding) (string, bool) {
+	if b.flagValue != nil {
+		return *b.flagValue, true
+	}
+
+	if b.Env != "" {
+		if value, ok := l.lookupEnv(b.Env); ok && value != "" {
+			return value, true
+		}
+	}
+
+	if b.Default != "" {
+		return b.Default, true
+	}
+
+	return "", false
+}
+
+// String, Set and IsBoolFlag implement flag.Value.
+func (b *binding) String() string {
+	return ""
+}
+
+func (b *binding) Set(value string) error {
+	b.flagValue = &value
+	return nil
+}
+
+func (b *binding) IsBoolFlag() bool {
+	return b.isBool
+}
+
+func (s Setting) name() string {
+	if s.Env != "" {
+		return s.Env
+	}
+
+	return "-" + s.Flag
+}
+
+func (b *binding) error(raw string, given bool, err error) SettingError {
+	if given && b.Secret {
+		raw = secretMask
+	}
+
+	return SettingError{
+		Setting: b.Setting,
+		Value:   raw,
+		Err:     err,
+	}
+}
+
+func (r Report) sort(settings []*binding) {
+	order := make(map[string]int, len(settings))
+	for i, b := range settings {
+		order[b.name()] = i
+	}
+
+	sort.SliceStable(r, func(i, j int) bool {
+		return order[r[i].Setting.name()] < order[r[j].Setting.name()]
+	})
+}
@@ -0,0 +1,120 @@
+package veeconfig
+
+import (
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	v "github.com/kumait/vee"
+)
+
+type config struct {
+	DatabaseURL string
+	Port        int
+	Timeout     time.Duration
+	APIKey      string
+	Debug       bool
+	Region      string
+}
+
+func load(env map[string]string, args ...string) (config, error) {
+	var cfg config
+	cfg.Region = "eu"
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	l := New(Flags(fs), LookupEnv(func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}))
+
+	String(l, &cfg.DatabaseURL, Setting{Env: "DATABASE_URL", Required: true}, v.URL(v.URLSchemes("postgres")))
+	Int(l, &cfg.Port, Setting{Env: "PORT", Flag: "port", Default: "8080"}, v.Range(1, 65535))
+	Duration(l, &cfg.Timeout, Setting{Env: "TIMEOUT", Default: "5s"}, v.Range(time.Second, time.Minute))
+	String(l, &cfg.APIKey, Setting{Env: "API_KEY", Required: true, Secret: true}, v.StrLen(32, 32), v.Hex())
+	Bool(l, &cfg.Debug, Setting{Flag: "debug"})
+	String(l, &cfg.Region, Setting{Env: "REGION"}, v.OneOf("eu", "us"))
+
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	return cfg, l.Load()
+}
+
+func TestLoad(t *testing.T) {
+	cfg, err := load(map[string]string{
+		"DATABASE_URL": "postgres://db/app",
+		"PORT":         "9000",
+		"API_KEY":      strings.Repeat("ab", 16),
+	}, "-port", "9001", "-debug")
+	if err != nil {
+		t.Fatalf("error(%v), should get nil but got error", err)
+	}
+
+	want := config{"postgres://db/app", 9001, 5 * time.Second, strings.Repeat("ab", 16), true, "eu"}
+	if cfg != want {
+		t.Errorf("should load %+v but got %+v", want, cfg)
+	}
+
+	cfg, err = load(map[string]string{
+		"DATABASE_URL": "postgres://db/app",
+		"API_KEY":      strings.Repeat("ab", 16),
+	}, "-debug=false")
+	if err != nil || cfg.Debug {
+		t.Errorf("error(%v), should load -debug=false but got %+v", err, cfg)
+	}
+
+	_, err = load(map[string]string{
+		"DATABASE_URL": "postgres://db/app",
+		"PORT":         "99999999999999999999",
+		"API_KEY":      strings.Repeat("ab", 16),
+	})
+	line := `PORT (-port) = "99999999999999999999": is out of range`
+	if err == nil || !strings.Contains(err.Error(), line) {
+		t.Errorf("error(%v), should report %q", err, line)
+	}
+}
+
+func TestReport(t *testing.T) {
+	_, err := load(map[string]string{
+		"PORT":    "80a",
+		"TIMEOUT": "2m",
+		"API_KEY": "hunter2",
+		"REGION":  "",
+	})
+
+	var report Report
+	if !errors.As(err, &report) {
+		t.Fatalf("error(%v), should be a Report", err)
+	}
+
+	want := []string{
+		"DATABASE_URL: is required",
+		`PORT (-port) = "80a": must be an integer`,
+		`TIMEOUT = "2m": is greater than maximum 1m0s`,
+		"API_KEY = ******: [must have 32 characters, invalid hex]",
+	}
+	if len(report) != len(want) {
+		t.Fatalf("should report %d settings but got %d:\n%v", len(want), len(report), err)
+	}
+
+	for i, prefix := range want[:len(want)-1] {
+		if !strings.HasPrefix(report[i].Error(), prefix) {
+			t.Errorf("report line should start with %q but got %q", prefix, report[i].Error())
+		}
+	}
+
+	if line := report[len(want)-1].Error(); line != want[len(want)-1] {
+		t.Errorf("secret report line should be %q but got %q", want[len(want)-1], line)
+	}
+
+	if strings.Contains(err.Error(), "hunter2") {
+		t.Errorf("report should mask secrets:\n%v", err)
+	}
+
+	if code := v.ErrorCode(report[0]); code != CodeRequired {
+		t.Errorf("should have code %q but got %q", CodeRequired, code)
+	}
+}
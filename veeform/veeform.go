@@ -0,0 +1,402 @@
+// Package veeform validates url.Values and multipart forms with vee constraints, errors are keyed by form field name.
+// The form is checked as a vee Schema with a Field per key, so the options of vee.CheckWith apply to it.
+package veeform
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	v "github.com/kumait/vee"
+)
+
+const (
+	CodeRequired = "form_required"
+	CodeCount    = "form_count"
+	CodeUnknown  = "form_unknown"
+	CodeFileSize = "file_size"
+	CodeFileType = "file_type"
+)
+
+const sniffLength = 512
+
+type (
+	ValuesConstraint struct {
+		Value        url.Values
+		Keys         []*KeyRule
+		AllowUnknown bool
+	}
+
+	// KeyRule checks the values of one key, MaxCount < 0 means no limit.
+	KeyRule struct {
+		Name        string
+		MinCount    int
+		MaxCount    int
+		Constraints []v.CheckableValue[string]
+	}
+
+	ValuesOption func(*ValuesConstraint)
+
+	FormConstraint struct {
+		Value             *multipart.Form
+		Values            *ValuesConstraint
+		Files             []*FileRule
+		AllowUnknownFiles bool
+	}
+
+	// FileRule checks the files uploaded under one key, MaxCount < 0 and MaxSize <= 0 mean no limit.
+	FileRule struct {
+		Name      string
+		MinCount  int
+		MaxCount  int
+		MaxSize   int64
+		MIMETypes []string
+		Filename  []v.CheckableValue[string]
+	}
+
+	FormOption func(*FormConstraint)
+
+	FileOption func(*FileRule)
+
+	// CountConstraint checks how many times a key is given.
+	CountConstraint[T any] struct {
+		Value    []T
+		MinCount int
+		MaxCount int
+	}
+
+	// UnknownConstraint rejects a key that has no rule.
+	UnknownConstraint[T any] struct {
+		Value T
+	}
+
+	// FileConstraint checks one uploaded file against a FileRule.
+	FileConstraint struct {
+		Value *multipart.FileHeader
+		Rule  *FileRule
+	}
+)
+
+// Values checks url.Values against the key rules, keys without a rule are rejected unless AllowUnknownKeys is given.
+func Values(opts ...ValuesOption) v.CheckableValue[url.Values] {
+	return newValues(opts)
+}
+
+// Key checks an optional key given once at most.
+func Key(name string, cons ...v.CheckableValue[string]) ValuesOption {
+	return RepeatedKey(name, 0, 1, cons...)
+}
+
+// RequiredKey checks a key that must be given exactly once.
+func RequiredKey(name string, cons ...v.CheckableValue[string]) ValuesOption {
+	return RepeatedKey(name, 1, 1, cons...)
+}
+
+// RepeatedKey checks a key given between min and max times, max < 0 means no limit, each value is checked against
+// cons.
+func RepeatedKey(name string, min, max int, cons ...v.CheckableValue[string]) ValuesOption {
+	return func(c *ValuesConstraint) {
+		c.Keys = append(c.Keys, &KeyRule{
+			Name:        name,
+			MinCount:    min,
+			MaxCount:    max,
+			Constraints: cons,
+		})
+	}
+}
+
+func AllowUnknownKeys() ValuesOption {
+	return func(c *ValuesConstraint) {
+		c.AllowUnknown = true
+	}
+}
+
+// Form checks a multipart form, its values are checked by the FormValues rules and its files by the File rules. Keys
+// and files without a rule are rejected unless AllowUnknownKeys or AllowUnknownFiles is given.
+func Form(opts ...FormOption) v.CheckableValue[*multipart.Form] {
+	c := &FormConstraint{
+		Values: newValues(nil),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func FormValues(opts ...ValuesOption) FormOption {
+	return func(c *FormConstraint) {
+		for _, opt := range opts {
+			opt(c.Values)
+		}
+	}
+}
+
+// File checks the files uploaded under name, by default the file is optional and may be given once at most.
+func File(name string, opts ...FileOption) FormOption {
+	return func(c *FormConstraint) {
+		r := &FileRule{
+			Name:     name,
+			MaxCount: 1,
+		}
+		for _, opt := range opts {
+			opt(r)
+		}
+		c.Files = append(c.Files, r)
+	}
+}
+
+func AllowUnknownFiles() FormOption {
+	return func(c *FormConstraint) {
+		c.AllowUnknownFiles = true
+	}
+}
+
+// FileCount accepts between min and max files, max < 0 means no limit.
+func FileCount(min, max int) FileOption {
+	return func(r *FileRule) {
+		r.MinCount = min
+		r.MaxCount = max
+	}
+}
+
+func MaxFileSize(bytes int64) FileOption {
+	return func(r *FileRule) {
+		r.MaxSize = bytes
+	}
+}
+
+// SniffedTypes accepts files whose content, as detected by http.DetectContentType, is one of types. A type such as
+// "image/*" accepts all of its subtypes. The Content-Type sent by the client is ignored.
+func SniffedTypes(types ...string) FileOption {
+	return func(r *FileRule) {
+		r.MIMETypes = types
+	}
+}
+
+// Filename checks the name of each file against cons, such as vee.Regex, errors are keyed by "filename".
+func Filename(cons ...v.CheckableValue[string]) FileOption {
+	return func(r *FileRule) {
+		r.Filename = cons
+	}
+}
+
+func newValues(opts []ValuesOption) *ValuesConstraint {
+	c := new(ValuesConstraint)
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *ValuesConstraint) SetValue(value url.Values) {
+	c.Value = value
+}
+
+func (c *ValuesConstraint) Check() error {
+	return c.CheckWithContext(nil)
+}
+
+func (c *ValuesConstraint) CheckWithContext(ctx *v.CheckContext) error {
+	return ctx.Check(v.Schema(c.fields()...))
+}
+
+// fields returns a Field per key rule and per unknown key.
+func (c *ValuesConstraint) fields() []v.Checkable {
+	var fields []v.Checkable
+	known := make(map[string]bool, len(c.Keys))
+	for _, r := range c.Keys {
+		known[r.Name] = true
+		fields = append(fields, repeatedField(r.Name, c.Value[r.Name], r.MinCount, r.MaxCount, r.Constraints...))
+	}
+
+	if !c.AllowUnknown {
+		for _, key := range sortedKeys(c.Value) {
+			if !known[key] {
+				fields = append(fields, v.Field[[]string](key, c.Value[key], new(UnknownConstraint[[]string])))
+			}
+		}
+	}
+
+	return fields
+}
+
+func (c *FormConstraint) SetValue(value *multipart.Form) {
+	c.Value = value
+}
+
+func (c *FormConstraint) Check() error {
+	return c.CheckWithContext(nil)
+}
+
+func (c *FormConstraint) CheckWithContext(ctx *v.CheckContext) error {
+	form := c.Value
+	if form == nil {
+		form = new(multipart.Form)
+	}
+
+	c.Values.SetValue(form.Value)
+	fields := c.Values.fields()
+
+	known := make(map[string]bool, len(c.Files))
+	for _, r := range c.Files {
+		known[r.Name] = true
+		fields = append(fields, repeatedField[*multipart.FileHeader](r.Name, form.File[r.Name], r.MinCount, r.MaxCount,
+			&FileConstraint{Rule: r}))
+	}
+
+	if !c.AllowUnknownFiles {
+		for _, key := range sortedKeys(form.File) {
+			if !known[key] {
+				unknown := new(UnknownConstraint[[]*multipart.FileHeader])
+				fields = append(fields, v.Field[[]*multipart.FileHeader](key, form.File[key], unknown))
+			}
+		}
+	}
+
+	return ctx.Check(v.Schema(fields...))
+}
+
+func (c *CountConstraint[T]) SetValue(value []T) {
+	c.Value = value
+}
+
+func (c *CountConstraint[T]) Check() error {
+	n, min, max := len(c.Value), c.MinCount, c.MaxCount
+	switch {
+	case n == 0 && min > 0:
+		return v.ConstraintError(CodeRequired, "is required")
+	case n < min || (max >= 0 && n > max):
+		if min == max {
+			return v.ConstraintError(CodeCount, fmt.Sprintf("must be given %d times", max))
+		} else if max == 1 {
+			return v.ConstraintError(CodeCount, "must be given once at most")
+		} else if max < 0 {
+			return v.ConstraintError(CodeCount, fmt.Sprintf("must be given %d times at least", min))
+		}
+		return v.ConstraintError(CodeCount, fmt.Sprintf("must be given between %d and %d times", min, max))
+	}
+
+	return nil
+}
+
+func (c *UnknownConstraint[T]) SetValue(value T) {
+	c.Value = value
+}
+
+func (c *UnknownConstraint[T]) Check() error {
+	return v.ConstraintError(CodeUnknown, "is not allowed")
+}
+
+func (c *FileConstraint) SetValue(value *multipart.FileHeader) {
+	c.Value = value
+}
+
+func (c *FileConstraint) Check() error {
+	return c.CheckWithContext(nil)
+}
+
+func (c *FileConstraint) CheckWithContext(ctx *v.CheckContext) error {
+	fh, r := c.Value, c.Rule
+	if r.MaxSize > 0 && fh.Size > r.MaxSize {
+		return v.ConstraintError(CodeFileSize, fmt.Sprintf("must be %s at most", formatBytes(r.MaxSize)))
+	}
+
+	if len(r.Filename) > 0 {
+		if err := ctx.Check(v.Field("filename", fh.Filename, r.Filename...)); err != nil {
+			return err
+		}
+	}
+
+	if len(r.MIMETypes) > 0 {
+		t, err := sniff(fh)
+		if err != nil {
+			return fmt.Errorf("cannot read file: %w", err)
+		}
+
+		if !matchMIMEType(t, r.MIMETypes) {
+			return v.ConstraintError(CodeFileType, fmt.Sprintf("has type %s, must be one of %s", t,
+				strings.Join(r.MIMETypes, ", ")))
+		}
+	}
+
+	return nil
+}
+
+// repeatedField checks that name is given between min and max times. A key given once at most is checked as a single
+// value, otherwise each value is checked and errors are keyed by "#index".
+func repeatedField[T any](name string, values []T, min, max int, cons ...v.CheckableValue[T]) v.Checkable {
+	count := &CountConstraint[T]{MinCount: min, MaxCount: max}
+	count.SetValue(values)
+	if err := count.Check(); err != nil || len(values) == 0 {
+		return v.Field[[]T](name, values, count)
+	}
+
+	if max == 1 {
+		return v.Field(name, values[0], cons...)
+	}
+
+	return v.Field(name, values, v.Each[[]T](cons...))
+}
+
+func sniff(fh *multipart.FileHeader) (string, error) {
+	f, err := fh.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	buf := make([]byte, sniffLength)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+
+	t, _, err := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+	if err != nil {
+		return "", err
+	}
+
+	return t, nil
+}
+
+func matchMIMEType(t string, types []string) bool {
+	for _, allowed := range types {
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok {
+			if strings.HasPrefix(t, prefix+"/") {
+				return true
+			}
+		} else if t == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20 && n%(1<<20) == 0:
+		return fmt.Sprintf("%d MiB", n>>20)
+	case n >= 1<<10 && n%(1<<10) == 0:
+		return fmt.Sprintf("%d KiB", n>>10)
+	default:
+		return fmt.Sprintf("%d bytes", n)
+	}
+}
+
+func sortedKeys[M ~map[string]E, E any](m M) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
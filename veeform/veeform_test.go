@@ -0,0 +1,170 @@
+package veeform
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	v "github.com/kumait/vee"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestValues(t *testing.T) {
+	rules := []ValuesOption{
+		RequiredKey("name", v.NotBlank(), v.StrMaxLen(10)),
+		Key("age", v.ParseInt(v.Range(0, 150))),
+		RepeatedKey("tag", 0, 3, v.Slug()),
+	}
+
+	var tests = []struct {
+		name  string
+		value string
+		field string
+		code  string
+	}{
+		{"valid", "name=bob&age=30&tag=a&tag=b", "", ""},
+		{"missing required", "age=30", "name", CodeRequired},
+		{"repeated single key", "name=bob&name=alice", "name", CodeCount},
+		{"too many repeats", "name=bob&tag=a&tag=b&tag=c&tag=d", "tag", CodeCount},
+		{"value constraint", "name=bob&age=200", "age", ""},
+		{"repeated value constraint", "name=bob&tag=a&tag=B%20C", "tag", ""},
+		{"unknown key", "name=bob&admin=1", "admin", CodeUnknown},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values, _ := url.ParseQuery(test.value)
+			err := v.Value(values, Values(rules...)).Check()
+			checkFieldError(t, err, test.field, test.code)
+		})
+	}
+
+	values, _ := url.ParseQuery("name=bob&admin=1")
+	if err := v.Value(values, Values(append(rules, AllowUnknownKeys())...)).Check(); err != nil {
+		t.Errorf("error(%v), should get nil but got error", err)
+	}
+}
+
+func TestValuesCheckOptions(t *testing.T) {
+	values, _ := url.ParseQuery("age=200&tag=B%20C&admin=1")
+	check := v.Value(values, Values(
+		RequiredKey("name", v.NotBlank()),
+		Key("age", v.ParseInt(v.Range(0, 150))),
+		RepeatedKey("tag", 0, 3, v.Slug()),
+	))
+
+	err := v.CheckWith(check, v.WithSemantic(v.CheckSemanticAll))
+	if strings.Join(fieldNames(err), ",") != "name,age,tag,admin" {
+		t.Errorf("error(%v), should report every key", err)
+	}
+
+	err = v.CheckWith(check, v.WithMask("age"), v.WithSemantic(v.CheckSemanticAll))
+	checkFieldError(t, err, "age", "")
+
+	var trace v.Trace
+	_ = v.CheckWith(check, v.WithTrace(&trace))
+	if !strings.Contains(trace.String(), "Field at name -> fail") {
+		t.Errorf("trace should reach form keys but got:\n%v", trace)
+	}
+}
+
+func TestForm(t *testing.T) {
+	rules := []FormOption{
+		FormValues(RequiredKey("title", v.NotBlank())),
+		File("avatar",
+			FileCount(1, 1),
+			MaxFileSize(1<<10),
+			SniffedTypes("image/*"),
+			Filename(v.Regex(regexp.MustCompile(`^[\w-]+\.(png|jpe?g)$`))),
+		),
+		File("attachments", FileCount(0, 2)),
+	}
+
+	type upload struct {
+		field, filename string
+		content         []byte
+	}
+
+	var tests = []struct {
+		name  string
+		files []upload
+		field string
+		code  string
+	}{
+		{"valid", []upload{{"avatar", "me.png", pngHeader}}, "", ""},
+		{"missing file", nil, "avatar", CodeRequired},
+		{"too large", []upload{{"avatar", "me.png", append(pngHeader, make([]byte, 2000)...)}}, "avatar", CodeFileSize},
+		{"wrong type", []upload{{"avatar", "me.png", []byte("hello, world")}}, "avatar", CodeFileType},
+		{"bad filename", []upload{{"avatar", "my photo.png", pngHeader}}, "avatar", ""},
+		{"too many files", []upload{
+			{"avatar", "me.png", pngHeader},
+			{"attachments", "a.txt", nil},
+			{"attachments", "b.txt", nil},
+			{"attachments", "c.txt", nil},
+		}, "attachments", CodeCount},
+		{"unknown file", []upload{{"avatar", "me.png", pngHeader}, {"other", "x.txt", nil}}, "other", CodeUnknown},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body bytes.Buffer
+			w := multipart.NewWriter(&body)
+			w.WriteField("title", "hello")
+			for _, u := range test.files {
+				fw, _ := w.CreateFormFile(u.field, u.filename)
+				fw.Write(u.content)
+			}
+			w.Close()
+
+			form, err := multipart.NewReader(&body, w.Boundary()).ReadForm(1 << 20)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer form.RemoveAll()
+
+			err = v.Value(form, Form(rules...)).Check()
+			checkFieldError(t, err, test.field, test.code)
+		})
+	}
+}
+
+func checkFieldError(t *testing.T, err error, field, code string) {
+	t.Helper()
+	if field == "" {
+		if err != nil {
+			t.Errorf("error(%v), should get nil but got error", err)
+		}
+		return
+	}
+
+	var ef v.ErrField
+	if !errors.As(err, &ef) || ef.FieldName != field {
+		t.Errorf("error(%v), should be keyed by %q", err, field)
+		return
+	}
+
+	if code != "" && v.ErrorCode(err) != code {
+		t.Errorf("error(%v), should have code %q but got %q", err, code, v.ErrorCode(err))
+	}
+}
+
+// fieldNames returns the names of the outermost ErrFields in err.
+func fieldNames(err error) []string {
+	switch e := err.(type) {
+	case v.ErrList:
+		var names []string
+		for _, item := range e {
+			names = append(names, fieldNames(item)...)
+		}
+		return names
+	case v.ErrField:
+		return []string{e.FieldName}
+	}
+
+	return nil
+}
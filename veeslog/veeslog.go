@@ -0,0 +1,57 @@
+// Package veeslog is a vee.Observer that logs validation with log/slog.
+package veeslog
+
+import (
+	"log/slog"
+	"time"
+
+	v "github.com/kumait/vee"
+)
+
+// Observer logs failed fields and constraints at debug level, slow Func constraints at warn level and the result of
+// each check at debug level.
+type Observer struct {
+	Logger *slog.Logger
+
+	// SlowFunc is the duration above which a Func constraint is logged as slow, 0 disables it.
+	SlowFunc time.Duration
+}
+
+// New returns an Observer logging to logger, slog.Default() if nil.
+func New(logger *slog.Logger, slowFunc time.Duration) *Observer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &Observer{
+		Logger:   logger,
+		SlowFunc: slowFunc,
+	}
+}
+
+func (o *Observer) CheckStarted(v.Checkable) {}
+
+func (o *Observer) CheckFinished(_ v.Checkable, err error, elapsed time.Duration) {
+	o.Logger.Debug("validation finished", slog.Bool("valid", err == nil), slog.Duration("elapsed", elapsed))
+}
+
+func (o *Observer) FieldChecked(path string, err error, elapsed time.Duration) {
+	if err != nil {
+		o.Logger.Debug("field failed validation", slog.String("field", path), slog.Duration("elapsed", elapsed))
+	}
+}
+
+func (o *Observer) ConstraintFailed(path, constraint, code string, err error) {
+	o.Logger.Debug("constraint failed",
+		slog.String("field", path),
+		slog.String("constraint", constraint),
+		slog.String("code", code),
+		slog.String("error", err.Error()),
+	)
+}
+
+func (o *Observer) FuncChecked(path string, err error, elapsed time.Duration) {
+	if o.SlowFunc > 0 && elapsed > o.SlowFunc {
+		o.Logger.Warn("slow validation func", slog.String("field", path), slog.Duration("elapsed", elapsed))
+	}
+}
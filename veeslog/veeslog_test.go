@@ -0,0 +1,40 @@
+package veeslog
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	v "github.com/kumait/vee"
+)
+
+func TestObserver(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	schema := v.Schema(
+		v.Func(func() error {
+			time.Sleep(2 * time.Millisecond)
+			return nil
+		}),
+		v.Field("email", "bob@", v.Email()),
+	)
+
+	if err := v.CheckWith(schema, v.WithObserver(New(logger, time.Millisecond))); err == nil {
+		t.Fatal("should get an error but got nil")
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`msg="constraint failed" field=email constraint=Email code="" error="invalid email"`,
+		`msg="field failed validation" field=email`,
+		`level=WARN msg="slow validation func"`,
+		`msg="validation finished" valid=false`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log should contain %q but got:\n%s", want, out)
+		}
+	}
+}
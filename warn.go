@@ -0,0 +1,50 @@
+package vee
+
+type WarnConstraint[T any] struct {
+	Value      T
+	Constraint CheckableValue[T]
+}
+
+// Warn turns the failures of cons into warnings, they never fail the check and are reported through CollectWarnings.
+func Warn[T any](cons ...CheckableValue[T]) CheckableValue[T] {
+	return &WarnConstraint[T]{
+		Constraint: defaultConstraints(cons),
+	}
+}
+
+// CollectWarnings appends the warnings of the call to dst, each one as an ErrField named by the dotted path of its
+// field such as "items.#1.name", so dst.Dto() can be serialized next to the data.
+func CollectWarnings(dst *ErrList) CheckOption {
+	return func(ctx *CheckContext) {
+		ctx.warnings = dst
+	}
+}
+
+func (c *WarnConstraint[T]) SetValue(value T) {
+	c.Value = value
+	c.Constraint.SetValue(value)
+}
+
+func (c *WarnConstraint[T]) Check() error {
+	return c.checkWith(nil)
+}
+
+func (c *WarnConstraint[T]) checkWith(ctx *CheckContext) error {
+	if err := ctx.check(c.Constraint); err != nil {
+		ctx.warn(err)
+	}
+
+	return nil
+}
+
+func (ctx *CheckContext) warn(err error) {
+	if ctx == nil || ctx.warnings == nil {
+		return
+	}
+
+	err = ctx.redact(err)
+	if ctx.path != "" {
+		err = FieldError(ctx.path, err)
+	}
+	*ctx.warnings = append(*ctx.warnings, err)
+}
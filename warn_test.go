@@ -0,0 +1,68 @@
+package vee
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWarnings(t *testing.T) {
+	type member struct {
+		DisplayName string
+		Plan        string
+	}
+
+	m := member{DisplayName: strings.Repeat("a", 40), Plan: "legacy"}
+	schema := Schema(
+		Field("display_name", m.DisplayName, NotBlank(), Warn(StrMaxLen(30))),
+		Field("plan", m.Plan, OneOf("free", "pro", "legacy"), Warn(NotIn(map[string]bool{"legacy": true}))),
+	)
+
+	var warnings ErrList
+	if err := CheckWith(schema, CollectWarnings(&warnings)); err != nil {
+		t.Errorf("error(%v), should get nil but got error", err)
+	}
+
+	if err := schema.Check(); err != nil {
+		t.Errorf("error(%v), should get nil but got error", err)
+	}
+
+	dto := warnings.Dto()
+	if len(dto) != 2 || dto[0]["display_name"] == "" || dto[1]["plan"] == "" {
+		t.Errorf("should get warnings for display_name and plan but got %v", dto)
+	}
+
+	// a warning does not hide errors
+	warnings = nil
+	err := CheckWith(Value("", NotBlank(), Warn(StrMinLen(3))), WithSemantic(CheckSemanticAll), CollectWarnings(&warnings))
+	if err == nil || len(warnings) != 1 {
+		t.Errorf("error(%v), should get an error and 1 warning but got %v", err, warnings)
+	}
+
+	// a warning inside Each is reported once, at the index of its element
+	warnings = nil
+	items := []lineItem{{Name: "pen", Quantity: 1}, {Name: "whiteboard marker", Quantity: 0}}
+	err = CheckWith(Field("items", items, Each[[]lineItem]()), WithSemantic(CheckSemanticAll),
+		CollectWarnings(&warnings))
+	if err == nil || err.Error() != "items: #1: quantity: must be positive" {
+		t.Errorf("error(%v), should get an error for items #1", err)
+	}
+	if len(warnings) != 1 || warnings[0].(ErrField).FieldName != "items.#1.name" {
+		t.Errorf("should get 1 warning for items.#1.name but got %v", warnings)
+	}
+
+	if err := CheckWith(Field("items", items, Each[[]lineItem]()), WithMask("items.name")); err != nil {
+		t.Errorf("error(%v), a mask should match the fields of every element", err)
+	}
+}
+
+type lineItem struct {
+	Name     string
+	Quantity int
+}
+
+func (l lineItem) Schema() Checkable {
+	return Schema(
+		Field("name", l.Name, NotBlank(), Warn(StrMaxLen(10))),
+		Field("quantity", l.Quantity, Positive[int]()),
+	)
+}